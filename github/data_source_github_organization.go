@@ -0,0 +1,103 @@
+package github
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"login": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_repository_permission": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members_can_create_repositories": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"public_repos": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_private_repos": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"owned_private_repos": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"public_gists": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"private_gists": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"collaborators": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk_usage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Organization: %s", orgName)
+
+	client := meta.(*Organization).client
+
+	org, _, err := getOrganizationSettings(client, orgName)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(org.GetID(), 10))
+	d.Set("login", org.GetLogin())
+	d.Set("name", org.GetName())
+	d.Set("description", org.GetDescription())
+	d.Set("plan", org.GetPlan().GetName())
+	d.Set("billing_email", org.GetBillingEmail())
+	d.Set("default_repository_permission", org.DefaultRepositoryPermission)
+	d.Set("members_can_create_repositories", org.MembersCanCreateRepositories)
+	d.Set("public_repos", org.GetPublicRepos())
+	d.Set("total_private_repos", org.GetTotalPrivateRepos())
+	d.Set("owned_private_repos", org.GetOwnedPrivateRepos())
+	d.Set("public_gists", org.GetPublicGists())
+	d.Set("private_gists", org.GetPrivateGists())
+	d.Set("collaborators", org.GetCollaborators())
+	d.Set("disk_usage", org.GetDiskUsage())
+
+	return nil
+}
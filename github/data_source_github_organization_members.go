@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"role": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "admin", "member"}),
+			},
+			"filter": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "2fa_disabled"}),
+			},
+			"usernames": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+	role := d.Get("role").(string)
+	filter := d.Get("filter").(string)
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Organization Members: %s (%s, %s)", orgName, role, filter)
+
+	client := meta.(*Organization).client
+	ctx := context.Background()
+
+	opt := &github.ListMembersOptions{
+		Role:        role,
+		Filter:      filter,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	usernames := []string{}
+	for {
+		users, resp, err := client.Organizations.ListMembers(ctx, orgName, opt)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range users {
+			usernames = append(usernames, u.GetLogin())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(orgName)
+	d.Set("usernames", usernames)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationMembersDataSource_existing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "github_organization_members" "test" {
+					role = "admin"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_organization_members.test", "usernames.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubOrganizationMembersDataSource_invalidRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "github_organization_members" "test" {
+					role = "maintainer"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`maintainer is an invalid value for argument role`),
+			},
+		},
+	})
+}
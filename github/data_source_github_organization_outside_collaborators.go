@@ -0,0 +1,180 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganizationOutsideCollaborators() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationOutsideCollaboratorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "2fa_disabled"}),
+			},
+			"include_repositories": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"usernames": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"collaborators": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repositories": outsideCollaboratorRepositoriesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationOutsideCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
+	filter := d.Get("filter").(string)
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Outside Collaborators: %s (%s)", orgName, filter)
+
+	client := meta.(*Organization).client
+	ctx := context.Background()
+
+	opt := &github.ListOutsideCollaboratorsOptions{
+		Filter:      filter,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	usernames := []string{}
+	for {
+		users, resp, err := client.Organizations.ListOutsideCollaborators(ctx, orgName, opt)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range users {
+			usernames = append(usernames, u.GetLogin())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	collaborators := []interface{}{}
+	if d.Get("include_repositories").(bool) {
+		repositories, err := listOutsideCollaboratorRepositories(client, orgName)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range usernames {
+			r, ok := repositories[u]
+			if !ok {
+				r = []interface{}{}
+			}
+			collaborators = append(collaborators, map[string]interface{}{
+				"username":     u,
+				"repositories": r,
+			})
+		}
+	}
+
+	d.SetId(orgName)
+	d.Set("usernames", usernames)
+	d.Set("collaborators", collaborators)
+
+	return nil
+}
+
+// outsideCollaboratorRepositoriesSchema is the list of repositories of an
+// outside collaborator, along with the collaborator's permission on each.
+func outsideCollaboratorRepositoriesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"permission": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// listOutsideCollaboratorRepositories returns the repositories of the
+// organization each outside collaborator has access to, keyed by login.
+// There is no endpoint listing the repositories of a collaborator, so the
+// outside collaborators of every repository are listed in a single pass.
+func listOutsideCollaboratorRepositories(client *github.Client, orgName string) (map[string][]interface{}, error) {
+	ctx := context.Background()
+	repositories := make(map[string][]interface{})
+
+	opt := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, orgName, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range repos {
+			collabOpt := &github.ListCollaboratorsOptions{
+				Affiliation: "outside",
+				ListOptions: github.ListOptions{PerPage: maxPerPage},
+			}
+			for {
+				collaborators, collabResp, err := client.Repositories.ListCollaborators(ctx, orgName, r.GetName(), collabOpt)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, c := range collaborators {
+					permName, err := getRepoPermission(c.Permissions)
+					if err != nil {
+						return nil, err
+					}
+
+					repositories[c.GetLogin()] = append(repositories[c.GetLogin()], map[string]interface{}{
+						"name":       r.GetName(),
+						"permission": permName,
+					})
+				}
+
+				if collabResp.NextPage == 0 {
+					break
+				}
+				collabOpt.Page = collabResp.NextPage
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return repositories, nil
+}
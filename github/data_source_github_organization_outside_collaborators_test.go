@@ -0,0 +1,73 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationOutsideCollaboratorsDataSource_invalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckGithubOrganizationOutsideCollaboratorsDataSourceConfig("invalid"),
+				ExpectError: regexp.MustCompile(`invalid is an invalid value for argument filter`),
+			},
+		},
+	})
+}
+
+func TestAccGithubOrganizationOutsideCollaboratorsDataSource_existing(t *testing.T) {
+	repoName := fmt.Sprintf("tf-acc-test-outside-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubOutsideCollaboratorRepositoriesDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_organization_outside_collaborators.test", "usernames.#"),
+					resource.TestCheckResourceAttrSet("data.github_outside_collaborator_repositories.test", "repositories.#"),
+					resource.TestCheckResourceAttrPair("data.github_organization_outside_collaborators.test", "collaborators.#",
+						"data.github_organization_outside_collaborators.test", "usernames.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubOrganizationOutsideCollaboratorsDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+data "github_organization_outside_collaborators" "test" {
+	filter = "%s"
+}
+`, filter)
+}
+
+func testAccCheckGithubOutsideCollaboratorRepositoriesDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+	name = "%s"
+}
+
+data "github_organization_outside_collaborators" "test" {
+	include_repositories = true
+	depends_on = ["github_repository.test"]
+}
+
+data "github_outside_collaborator_repositories" "test" {
+	username = "%s"
+	depends_on = ["github_repository.test"]
+}
+`, repoName, testCollaborator)
+}
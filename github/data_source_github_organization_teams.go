@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganizationTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"teams": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"privacy": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_team_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Organization Teams: %s", orgName)
+
+	client := meta.(*Organization).client
+	ctx := context.Background()
+
+	opt := &github.ListOptions{PerPage: maxPerPage}
+
+	teams := []interface{}{}
+	for {
+		orgTeams, resp, err := client.Organizations.ListTeams(ctx, orgName, opt)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range orgTeams {
+			member, err := listGithubTeamMembers(client, t.GetID(), "all")
+			if err != nil {
+				return err
+			}
+
+			members := []string{}
+			for _, v := range member {
+				members = append(members, v.GetLogin())
+			}
+
+			parentTeamID := ""
+			if parent := t.Parent; parent != nil {
+				parentTeamID = fromGithubID(parent.ID)
+			}
+
+			teams = append(teams, map[string]interface{}{
+				"id":             fromGithubID(t.ID),
+				"slug":           t.GetSlug(),
+				"name":           t.GetName(),
+				"description":    t.GetDescription(),
+				"privacy":        t.GetPrivacy(),
+				"parent_team_id": parentTeamID,
+				"members":        members,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(orgName)
+	d.Set("teams", teams)
+
+	return nil
+}
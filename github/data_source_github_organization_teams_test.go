@@ -0,0 +1,41 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationTeamsDataSource_existing(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubOrganizationTeamsDataSourceConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_organization_teams.test", "teams.#"),
+					resource.TestCheckResourceAttrSet("data.github_organization_teams.test", "teams.0.slug"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubOrganizationTeamsDataSourceConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+	name = "tf-acc-test-org-teams-%s"
+}
+
+data "github_organization_teams" "test" {
+	depends_on = ["github_team.test"]
+}
+`, randString)
+}
@@ -0,0 +1,29 @@
+package github
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationDataSource_existing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "github_organization" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("data.github_organization.test", "plan"),
+					resource.TestCheckResourceAttr("data.github_organization.test", "login", os.Getenv("GITHUB_ORGANIZATION")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganizationWebhooks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"webhooks": dataSourceGithubWebhooksSchema(),
+		},
+	}
+}
+
+func dataSourceGithubOrganizationWebhooksRead(d *schema.ResourceData, meta interface{}) error {
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Organization Webhooks: %s", orgName)
+
+	client := meta.(*Organization).client
+
+	hooks, err := listGithubWebhooks(client, fmt.Sprintf("orgs/%v/hooks", orgName))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	d.Set("webhooks", flattenGithubWebhooks(hooks))
+
+	return nil
+}
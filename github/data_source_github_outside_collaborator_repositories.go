@@ -0,0 +1,44 @@
+package github
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOutsideCollaboratorRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOutsideCollaboratorRepositoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repositories": outsideCollaboratorRepositoriesSchema(),
+		},
+	}
+}
+
+func dataSourceGithubOutsideCollaboratorRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+	username := d.Get("username").(string)
+	orgName := meta.(*Organization).name
+	log.Printf("[INFO] Refreshing Gitub Outside Collaborator Repositories: %s (%s)", orgName, username)
+
+	client := meta.(*Organization).client
+
+	repositories, err := listOutsideCollaboratorRepositories(client, orgName)
+	if err != nil {
+		return err
+	}
+
+	r, ok := repositories[username]
+	if !ok {
+		r = []interface{}{}
+	}
+
+	d.SetId(buildTwoPartID(&orgName, &username))
+	d.Set("repositories", r)
+
+	return nil
+}
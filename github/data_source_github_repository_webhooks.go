@@ -0,0 +1,40 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubRepositoryWebhooks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"webhooks": dataSourceGithubWebhooksSchema(),
+		},
+	}
+}
+
+func dataSourceGithubRepositoryWebhooksRead(d *schema.ResourceData, meta interface{}) error {
+	orgName := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	log.Printf("[INFO] Refreshing Gitub Repository Webhooks: %s/%s", orgName, repoName)
+
+	client := meta.(*Organization).client
+
+	hooks, err := listGithubWebhooks(client, fmt.Sprintf("repos/%v/%v/hooks", orgName, repoName))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+	d.Set("webhooks", flattenGithubWebhooks(hooks))
+
+	return nil
+}
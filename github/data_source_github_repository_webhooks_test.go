@@ -0,0 +1,56 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryWebhooksDataSource_existing(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubRepositoryWebhooksDataSourceConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository_webhooks.test", "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.github_repository_webhooks.test", "webhooks.0.id", "github_repository_webhook.test", "id"),
+					resource.TestCheckResourceAttr("data.github_repository_webhooks.test", "webhooks.0.url", "https://google.de/webhook"),
+					resource.TestCheckResourceAttr("data.github_repository_webhooks.test", "webhooks.0.events.#", "1"),
+					resource.TestCheckNoResourceAttr("data.github_repository_webhooks.test", "webhooks.0.secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryWebhooksDataSourceConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+	name = "tf-acc-test-webhooks-%s"
+}
+
+resource "github_repository_webhook" "test" {
+	repository = "${github_repository.test.name}"
+	name = "web"
+	events = ["push"]
+
+	configuration {
+		url = "https://google.de/webhook"
+		secret = "secret"
+	}
+}
+
+data "github_repository_webhooks" "test" {
+	repository = "${github_repository.test.name}"
+	depends_on = ["github_repository_webhook.test"]
+}
+`, randString)
+}
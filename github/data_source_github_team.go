@@ -15,13 +15,23 @@ func dataSourceGithubTeam() *schema.Resource {
 		Read: dataSourceGithubTeamRead,
 
 		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"slug", "name"},
+			},
 			"slug": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id", "name"},
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id", "slug"},
 			},
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -40,35 +50,148 @@ func dataSourceGithubTeam() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"memberships": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repositories": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent_team_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_team_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataSourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
-	slug := d.Get("slug").(string)
-	log.Printf("[INFO] Refreshing Gitub Team: %s", slug)
-
 	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
 	ctx := context.Background()
 
-	team, err := getGithubTeamBySlug(client, meta.(*Organization).name, slug)
-	if err != nil {
-		return err
+	var team *github.Team
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		log.Printf("[INFO] Refreshing Gitub Team: %s", id)
+		team, _, err = client.Organizations.GetTeam(ctx, toGithubID(id.(string)))
+	} else if name, ok := d.GetOk("name"); ok {
+		log.Printf("[INFO] Refreshing Gitub Team: %s", name)
+		team, err = getGithubTeamByName(client, orgName, name.(string))
+	} else if slug, ok := d.GetOk("slug"); ok {
+		log.Printf("[INFO] Refreshing Gitub Team: %s", slug)
+		team, err = getGithubTeamBySlug(client, orgName, slug.(string))
+	} else {
+		return fmt.Errorf("One of id, slug or name must be set")
 	}
-
-	member, _, err := client.Organizations.ListTeamMembers(ctx, team.GetID(), nil)
 	if err != nil {
 		return err
 	}
 
 	members := []string{}
-	for _, v := range member {
-		members = append(members, v.GetLogin())
+	memberships := []interface{}{}
+	for _, role := range []string{"maintainer", "member"} {
+		users, err := listGithubTeamMembers(client, team.GetID(), role)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range users {
+			members = append(members, v.GetLogin())
+			memberships = append(memberships, map[string]interface{}{
+				"username": v.GetLogin(),
+				"role":     role,
+			})
+		}
+	}
+
+	repositories := []interface{}{}
+	repoOpt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		repos, resp, err := client.Organizations.ListTeamRepos(ctx, team.GetID(), repoOpt)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range repos {
+			permName, err := getRepoPermission(r.Permissions)
+			if err != nil {
+				return err
+			}
+
+			repositories = append(repositories, map[string]interface{}{
+				"name":       r.GetName(),
+				"permission": permName,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		repoOpt.Page = resp.NextPage
+	}
+
+	childTeamIDs := []string{}
+	childOpt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		children, resp, err := client.Organizations.ListChildTeams(ctx, team.GetID(), childOpt)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range children {
+			childTeamIDs = append(childTeamIDs, fromGithubID(c.ID))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		childOpt.Page = resp.NextPage
+	}
+
+	parentTeamID := ""
+	if parent := team.Parent; parent != nil {
+		parentTeamID = fromGithubID(parent.ID)
 	}
 
 	d.SetId(strconv.FormatInt(team.GetID(), 10))
+	d.Set("slug", team.GetSlug())
 	d.Set("name", team.GetName())
 	d.Set("members", members)
+	d.Set("memberships", memberships)
+	d.Set("repositories", repositories)
+	d.Set("parent_team_id", parentTeamID)
+	d.Set("child_team_ids", childTeamIDs)
 	d.Set("description", team.GetDescription())
 	d.Set("privacy", team.GetPrivacy())
 	d.Set("permission", team.GetPermission())
@@ -76,16 +199,38 @@ func dataSourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func getGithubTeamBySlug(client *github.Client, org string, slug string) (team *github.Team, err error) {
-	opt := &github.ListOptions{PerPage: 10}
+func getGithubTeamBySlug(client *github.Client, org string, slug string) (*github.Team, error) {
+	team, err := findGithubTeam(client, org, func(t *github.Team) bool {
+		return t.GetSlug() == slug
+	})
+	if err == nil && team == nil {
+		err = fmt.Errorf("Could not find team with slug: %s", slug)
+	}
+	return team, err
+}
+
+func getGithubTeamByName(client *github.Client, org string, name string) (*github.Team, error) {
+	team, err := findGithubTeam(client, org, func(t *github.Team) bool {
+		return t.GetName() == name
+	})
+	if err == nil && team == nil {
+		err = fmt.Errorf("Could not find team with name: %s", name)
+	}
+	return team, err
+}
+
+// findGithubTeam returns the first team of the organization for which match
+// returns true, or nil if there is none.
+func findGithubTeam(client *github.Client, org string, match func(*github.Team) bool) (*github.Team, error) {
+	opt := &github.ListOptions{PerPage: maxPerPage}
 	for {
 		teams, resp, err := client.Organizations.ListTeams(context.TODO(), org, opt)
 		if err != nil {
-			return team, err
+			return nil, err
 		}
 
 		for _, t := range teams {
-			if *t.Slug == slug {
+			if match(t) {
 				return t, nil
 			}
 		}
@@ -96,5 +241,31 @@ func getGithubTeamBySlug(client *github.Client, org string, slug string) (team *
 		opt.Page = resp.NextPage
 	}
 
-	return team, fmt.Errorf("Could not find team with slug: %s", slug)
+	return nil, nil
+}
+
+// listGithubTeamMembers returns all members of a team with the given role,
+// which is one of "all", "member" or "maintainer".
+func listGithubTeamMembers(client *github.Client, teamID int64, role string) ([]*github.User, error) {
+	opt := &github.OrganizationListTeamMembersOptions{
+		Role:        role,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var members []*github.User
+	for {
+		users, resp, err := client.Organizations.ListTeamMembers(context.TODO(), teamID, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, users...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return members, nil
 }
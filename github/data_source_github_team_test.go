@@ -3,8 +3,10 @@ package github
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -24,6 +26,77 @@ func TestAccGithubTeamDataSource_noMatchReturnsError(t *testing.T) {
 	})
 }
 
+func TestAccGithubTeamDataSource_lookups(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubTeamDataSourceLookupsConfig(randString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.github_team.by_id", "id", "github_team.child", "id"),
+					resource.TestCheckResourceAttrPair("data.github_team.by_name", "id", "github_team.child", "id"),
+					resource.TestCheckResourceAttrPair("data.github_team.by_slug", "id", "github_team.parent", "id"),
+					resource.TestCheckResourceAttrPair("data.github_team.by_id", "parent_team_id", "github_team.parent", "id"),
+					resource.TestCheckResourceAttr("data.github_team.by_slug", "child_team_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubTeamDataSource_conflictingLookups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_team" "test" {
+	slug = "example"
+	name = "Example"
+}
+`,
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubTeamDataSourceLookupsConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "parent" {
+	name = "tf-acc-test-parent-%s"
+	privacy = "closed"
+}
+
+resource "github_team" "child" {
+	name = "tf-acc-test-child-%s"
+	privacy = "closed"
+	parent_team_id = "${github_team.parent.id}"
+}
+
+data "github_team" "by_id" {
+	id = "${github_team.child.id}"
+}
+
+data "github_team" "by_name" {
+	name = "${github_team.child.name}"
+}
+
+data "github_team" "by_slug" {
+	slug = "tf-acc-test-parent-%s"
+	depends_on = ["github_team.child"]
+}
+`, randString, randString, strings.ToLower(randString))
+}
+
 func testAccCheckGithubTeamDataSourceConfig(slug string) string {
 	return fmt.Sprintf(`
 data "github_team" "test" {
@@ -32,23 +32,38 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"github_team":                    resourceGithubTeam(),
-			"github_team_membership":         resourceGithubTeamMembership(),
-			"github_team_repository":         resourceGithubTeamRepository(),
-			"github_membership":              resourceGithubMembership(),
-			"github_repository":              resourceGithubRepository(),
-			"github_repository_deploy_key":   resourceGithubRepositoryDeployKey(),
-			"github_repository_webhook":      resourceGithubRepositoryWebhook(),
-			"github_organization_webhook":    resourceGithubOrganizationWebhook(),
-			"github_repository_collaborator": resourceGithubRepositoryCollaborator(),
-			"github_issue_label":             resourceGithubIssueLabel(),
-			"github_branch_protection":       resourceGithubBranchProtection(),
+			"github_team":                     resourceGithubTeam(),
+			"github_team_membership":          resourceGithubTeamMembership(),
+			"github_team_members":             resourceGithubTeamMembers(),
+			"github_team_discussion":          resourceGithubTeamDiscussion(),
+			"github_team_repository":          resourceGithubTeamRepository(),
+			"github_membership":               resourceGithubMembership(),
+			"github_repository":               resourceGithubRepository(),
+			"github_repository_deploy_key":    resourceGithubRepositoryDeployKey(),
+			"github_repository_webhook":       resourceGithubRepositoryWebhook(),
+			"github_organization_webhook":     resourceGithubOrganizationWebhook(),
+			"github_organization_settings":    resourceGithubOrganizationSettings(),
+			"github_repository_collaborator":  resourceGithubRepositoryCollaborator(),
+			"github_repository_collaborators": resourceGithubRepositoryCollaborators(),
+			"github_repository_import":        resourceGithubRepositorySourceImport(),
+			"github_repository_transfer":      resourceGithubRepositoryTransfer(),
+			"github_issue_label":              resourceGithubIssueLabel(),
+			"github_branch_protection":        resourceGithubBranchProtection(),
+			"github_user_ssh_key":             resourceGithubUserSshKey(),
+			"github_user_gpg_key":             resourceGithubUserGpgKey(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"github_user":      dataSourceGithubUser(),
-			"github_team":      dataSourceGithubTeam(),
-			"github_ip_ranges": dataSourceGithubIpRanges(),
+			"github_user":                               dataSourceGithubUser(),
+			"github_team":                               dataSourceGithubTeam(),
+			"github_ip_ranges":                          dataSourceGithubIpRanges(),
+			"github_organization":                       dataSourceGithubOrganization(),
+			"github_organization_members":               dataSourceGithubOrganizationMembers(),
+			"github_organization_teams":                 dataSourceGithubOrganizationTeams(),
+			"github_organization_webhooks":              dataSourceGithubOrganizationWebhooks(),
+			"github_organization_outside_collaborators": dataSourceGithubOrganizationOutsideCollaborators(),
+			"github_outside_collaborator_repositories":  dataSourceGithubOutsideCollaboratorRepositories(),
+			"github_repository_webhooks":                dataSourceGithubRepositoryWebhooks(),
 		},
 	}
 
@@ -19,12 +19,12 @@ func resourceGithubBranchProtection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryRenameCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"branch": {
 				Type:     schema.TypeString,
@@ -131,6 +131,10 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 	r := d.Get("repository").(string)
 	b := d.Get("branch").(string)
 
+	if err := checkGithubRepositoryWritable(client, meta.(*Organization).name, r); err != nil {
+		return err
+	}
+
 	protectionRequest, err := buildProtectionRequest(d)
 	if err != nil {
 		return err
@@ -182,6 +186,29 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 	client := meta.(*Organization).client
 	r, b := parseTwoPartID(d.Id())
 
+	if d.HasChange("repository") {
+		n := d.Get("repository").(string)
+		renamed, err := isGithubRepositoryRenamed(client, meta.(*Organization).name, r, n)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return moveGithubRepositoryResource(d, meta, r, func() error {
+				_, err := client.Repositories.RemoveBranchProtection(context.TODO(), meta.(*Organization).name, r, b)
+				return err
+			}, resourceGithubBranchProtectionCreate)
+		}
+		r = n
+	}
+
+	if deferred, err := deferWriteToArchivedGithubRepository(client, meta.(*Organization).name, r, "protection of branch "+b); err != nil || deferred {
+		if err != nil {
+			return err
+		}
+		d.SetId(buildTwoPartID(&r, &b))
+		return resourceGithubBranchProtectionRead(d, meta)
+	}
+
 	protectionRequest, err := buildProtectionRequest(d)
 	if err != nil {
 		return err
@@ -208,6 +235,10 @@ func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface
 	client := meta.(*Organization).client
 	r, b := parseTwoPartID(d.Id())
 
+	if skip, err := skipDeleteOfArchivedGithubRepository(client, meta.(*Organization).name, r, "protection of branch "+b); err != nil || skip {
+		return err
+	}
+
 	_, err := client.Repositories.RemoveBranchProtection(context.TODO(), meta.(*Organization).name, r, b)
 	return err
 }
@@ -17,12 +17,12 @@ func resourceGithubIssueLabel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryRenameCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -62,6 +62,34 @@ func resourceGithubIssueLabelCreateOrUpdate(d *schema.ResourceData, meta interfa
 		Color: &c,
 	}
 
+	if d.Id() != "" && d.HasChange("repository") {
+		oldRepo, oldName := parseTwoPartID(d.Id())
+		renamed, err := isGithubRepositoryRenamed(client, o, oldRepo, r)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return moveGithubRepositoryResource(d, meta, oldRepo, func() error {
+				_, err := client.Issues.DeleteLabel(context.TODO(), o, oldRepo, oldName)
+				return err
+			}, resourceGithubIssueLabelCreateOrUpdate)
+		}
+	}
+
+	if d.Id() == "" {
+		if err := checkGithubRepositoryWritable(client, o, r); err != nil {
+			return err
+		}
+	} else if deferred, err := deferWriteToArchivedGithubRepository(client, o, r, "label "+n); err != nil || deferred {
+		if err != nil {
+			return err
+		}
+		// Keep the label as it is, following a rename of the repository.
+		_, oname := parseTwoPartID(d.Id())
+		d.SetId(buildTwoPartID(&r, &oname))
+		return resourceGithubIssueLabelRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Querying label existence %s/%s (%s)", o, r, n)
 	existing, _, _ := client.Issues.GetLabel(context.TODO(), o, r, n)
 
@@ -121,6 +149,10 @@ func resourceGithubIssueLabelDelete(d *schema.ResourceData, meta interface{}) er
 	r := d.Get("repository").(string)
 	n := d.Get("name").(string)
 
+	if skip, err := skipDeleteOfArchivedGithubRepository(client, meta.(*Organization).name, r, "label "+n); err != nil || skip {
+		return err
+	}
+
 	log.Printf("[DEBUG] Deleting label: %s/%s", r, n)
 	_, err := client.Issues.DeleteLabel(context.TODO(), meta.(*Organization).name, r, n)
 	return err
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/go-github/github"
@@ -40,6 +41,41 @@ func TestAccGithubIssueLabel_basic(t *testing.T) {
 	})
 }
 
+func TestAccGithubIssueLabel_archivedRepository(t *testing.T) {
+	rString := acctest.RandString(5)
+	repoName := fmt.Sprintf("tf-acc-test-branch-issue-label-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGithubIssueLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubIssueLabelArchivedConfig(repoName, false, "000000"),
+			},
+			{
+				Config: testAccGithubIssueLabelArchivedConfig(repoName, true, "000000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.test", "archived", "true"),
+				),
+			},
+			{
+				// The change is deferred until the repository is unarchived.
+				Config: testAccGithubIssueLabelArchivedConfig(repoName, true, "FFFFFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_issue_label.test", "color", "000000"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccGithubIssueLabelArchivedConfig(repoName, true, "FFFFFF") + testAccGithubIssueLabelArchivedNewConfig,
+				ExpectError: regexp.MustCompile("is archived and therefore read-only"),
+			},
+			// Destroying the label of the archived repository only removes it from the state.
+		},
+	})
+}
+
 func TestAccGithubIssueLabel_existingLabel(t *testing.T) {
 	var label github.Label
 
@@ -190,3 +226,26 @@ resource "github_issue_label" "test" {
 }
 `, repoName)
 }
+
+func testAccGithubIssueLabelArchivedConfig(repoName string, archived bool, color string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+  archived = %t
+}
+
+resource "github_issue_label" "test" {
+  repository = "${github_repository.test.name}"
+  name       = "foo"
+  color      = "%s"
+}
+`, repoName, archived, color)
+}
+
+const testAccGithubIssueLabelArchivedNewConfig = `
+resource "github_issue_label" "new" {
+  repository = "${github_repository.test.name}"
+  name       = "bar"
+  color      = "000000"
+}
+`
@@ -0,0 +1,143 @@
+package github
+
+import (
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSettingsCreateOrUpdate,
+		Read:   resourceGithubOrganizationSettingsRead,
+		Update: resourceGithubOrganizationSettingsCreateOrUpdate,
+		Delete: resourceGithubOrganizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"blog": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_repository_permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc([]string{"read", "write", "admin", "none"}),
+			},
+			"members_can_create_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGithubOrganizationSettingsCreateOrUpdate edits the settings of the
+// organization configured on the provider. The organization always exists, so
+// creating the resource only takes over the settings that are configured and
+// leaves the others untouched.
+func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+
+	settings := &organizationSettings{Organization: &github.Organization{}}
+	if v, ok := d.GetOk("billing_email"); ok {
+		settings.BillingEmail = github.String(v.(string))
+	}
+	// The profile fields can be cleared, which GetOk can't tell apart from
+	// leaving them out, so a changed field is also sent when it is empty.
+	profile := map[string]**string{
+		"name":        &settings.Name,
+		"company":     &settings.Company,
+		"blog":        &settings.Blog,
+		"email":       &settings.Email,
+		"location":    &settings.Location,
+		"description": &settings.Description,
+	}
+	for k, field := range profile {
+		if _, ok := d.GetOk(k); ok || d.HasChange(k) {
+			*field = github.String(d.Get(k).(string))
+		}
+	}
+	if v, ok := d.GetOk("default_repository_permission"); ok {
+		settings.DefaultRepositoryPermission = github.String(v.(string))
+	}
+	if v, ok := d.GetOkExists("members_can_create_repositories"); ok {
+		settings.MembersCanCreateRepositories = github.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Updating organization settings: %s", orgName)
+	org, _, err := editOrganizationSettings(client, orgName, settings)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(org.GetLogin())
+
+	return resourceGithubOrganizationSettingsRead(d, meta)
+}
+
+func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	log.Printf("[DEBUG] Reading organization settings: %s", d.Id())
+	org, _, err := getOrganizationSettings(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("billing_email", org.GetBillingEmail())
+	d.Set("name", org.GetName())
+	d.Set("company", org.GetCompany())
+	d.Set("blog", org.GetBlog())
+	d.Set("email", org.GetEmail())
+	d.Set("location", org.GetLocation())
+	d.Set("description", org.GetDescription())
+	d.Set("default_repository_permission", org.DefaultRepositoryPermission)
+	d.Set("members_can_create_repositories", org.MembersCanCreateRepositories)
+
+	return nil
+}
+
+func resourceGithubOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The organization can't be deleted through the API, and there are no
+	// defaults to revert its settings to, so they are left as they are.
+	log.Printf("[DEBUG] Removing organization settings of %s from state", d.Id())
+	return nil
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationSettings_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationSettingsConfig(randString, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_settings.test", "description", fmt.Sprintf("Terraform acc test %s", randString)),
+					resource.TestCheckResourceAttr("github_organization_settings.test", "default_repository_permission", "read"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationSettingsConfig(randString, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_settings.test", "default_repository_permission", "none"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationSettingsClearedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_settings.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubOrganizationSettings_importBasic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationSettingsConfig(randString, "read"),
+			},
+			{
+				ResourceName:      "github_organization_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationSettingsConfig(randString, permission string) string {
+	return fmt.Sprintf(`
+resource "github_organization_settings" "test" {
+  description = "Terraform acc test %s"
+  default_repository_permission = "%s"
+}
+`, randString, permission)
+}
+
+const testAccGithubOrganizationSettingsClearedConfig = `
+resource "github_organization_settings" "test" {
+  description = ""
+  default_repository_permission = "none"
+}
+`
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/google/go-github/github"
@@ -17,6 +18,10 @@ func resourceGithubOrganizationWebhook() *schema.Resource {
 		Update: resourceGithubOrganizationWebhookUpdate,
 		Delete: resourceGithubOrganizationWebhookDelete,
 
+		SchemaVersion: 1,
+		MigrateState:  resourceGithubWebhookMigrateState,
+		CustomizeDiff: resourceGithubWebhookCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -24,20 +29,28 @@ func resourceGithubOrganizationWebhook() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateGithubOrganizationWebhookName,
 			},
-			"events": &schema.Schema{
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
-			},
-			"configuration": {
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"events":        webhookEventsSchema(),
+			"configuration": webhookConfigurationSchema(),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"verify": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"ping"}),
+			},
+			"fail_on_verify_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_response": webhookLastResponseSchema(),
 			"active": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -69,7 +82,7 @@ func resourceGithubOrganizationWebhookObject(d *schema.ResourceData) *github.Hoo
 		URL:    &url,
 		Events: events,
 		Active: &active,
-		Config: d.Get("configuration").(map[string]interface{}),
+		Config: expandWebhookConfiguration(d),
 	}
 
 	return hook
@@ -77,22 +90,65 @@ func resourceGithubOrganizationWebhookObject(d *schema.ResourceData) *github.Hoo
 
 func resourceGithubOrganizationWebhookCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
+	org := meta.(*Organization).name
 	hk := resourceGithubOrganizationWebhookObject(d)
 
-	hook, _, err := client.Organizations.CreateHook(context.TODO(), meta.(*Organization).name, hk)
+	if url, ok := hk.Config["url"].(string); ok && d.Get("adopt_existing").(bool) {
+		existing, err := findGithubOrganizationWebhook(client, org, url)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			log.Printf("[DEBUG] Adopting existing organization webhook: %s (%d)", org, existing.GetID())
+			_, _, err = client.Organizations.EditHook(context.TODO(), org, existing.GetID(), hk)
+			if err != nil {
+				return err
+			}
+			d.SetId(strconv.FormatInt(existing.GetID(), 10))
+
+			return resourceGithubOrganizationWebhookVerifyAndRead(d, meta)
+		}
+	}
+
+	hook, _, err := client.Organizations.CreateHook(context.TODO(), org, hk)
 	if err != nil {
 		return err
 	}
 	d.SetId(strconv.FormatInt(*hook.ID, 10))
 
-	return resourceGithubOrganizationWebhookRead(d, meta)
+	return resourceGithubOrganizationWebhookVerifyAndRead(d, meta)
+}
+
+// resourceGithubOrganizationWebhookVerifyAndRead pings the hook when `verify`
+// is set, then reads the hook including the response of its receiver.
+func resourceGithubOrganizationWebhookVerifyAndRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	org := meta.(*Organization).name
+	hookID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	var verifyErr error
+	if _, ok := d.GetOk("verify"); ok {
+		u := fmt.Sprintf("orgs/%v/hooks/%d", org, hookID)
+		verifyErr = verifyGithubWebhook(client, u, "ping", d.Get("fail_on_verify_error").(bool), func() (*github.Response, error) {
+			return client.Organizations.PingHook(context.TODO(), org, hookID)
+		})
+	}
+
+	if err := resourceGithubOrganizationWebhookRead(d, meta); err != nil {
+		return err
+	}
+	return verifyErr
 }
 
 func resourceGithubOrganizationWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
 	hookID, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	hook, resp, err := client.Organizations.GetHook(context.TODO(), meta.(*Organization).name, hookID)
+	hook, resp, err := getGithubWebhook(client, fmt.Sprintf("orgs/%v/hooks/%d", meta.(*Organization).name, hookID))
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -104,7 +160,8 @@ func resourceGithubOrganizationWebhookRead(d *schema.ResourceData, meta interfac
 	d.Set("url", hook.URL)
 	d.Set("active", hook.Active)
 	d.Set("events", hook.Events)
-	d.Set("configuration", hook.Config)
+	d.Set("configuration", flattenWebhookConfiguration(d, hook.Config))
+	d.Set("last_response", flattenWebhookLastResponse(hook.LastResponse))
 
 	return nil
 }
@@ -122,7 +179,7 @@ func resourceGithubOrganizationWebhookUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return resourceGithubOrganizationWebhookRead(d, meta)
+	return resourceGithubOrganizationWebhookVerifyAndRead(d, meta)
 }
 
 func resourceGithubOrganizationWebhookDelete(d *schema.ResourceData, meta interface{}) error {
@@ -48,6 +48,7 @@ func TestAccGithubOrganizationWebhook_basic(t *testing.T) {
 							"url":          "https://google.de/webhooks",
 							"content_type": "form",
 							"insecure_ssl": "0",
+							"secret":       "********",
 						},
 						Active: false,
 					}),
@@ -158,6 +159,7 @@ resource "github_organization_webhook" "foo" {
     url = "https://google.de/webhooks"
     content_type = "form"
     insecure_ssl = false
+    secret = "secret"
   }
   active = false
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/google/go-github/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,14 +18,13 @@ func resourceGithubRepository() *schema.Resource {
 		Update: resourceGithubRepositoryUpdate,
 		Delete: resourceGithubRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGithubRepositoryImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -70,8 +70,9 @@ func resourceGithubRepository() *schema.Resource {
 				Default:  true,
 			},
 			"auto_init": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"fork_from", "template"},
 			},
 			"default_branch": {
 				Type:        schema.TypeString,
@@ -80,18 +81,62 @@ func resourceGithubRepository() *schema.Resource {
 				Description: "Can only be set after initial repository creation, and only if the target branch exists",
 			},
 			"license_template": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"fork_from", "template"},
 			},
 			"gitignore_template": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"fork_from", "template"},
+			},
+			"fork_from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"template"},
+				ValidateFunc:     validateRepositoryFullName,
+				DiffSuppressFunc: suppressRepositoryOriginDiff,
+			},
+			"template": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressRepositoryOriginDiff,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"is_template": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 			"archived": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"archive_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"topics": {
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -122,10 +167,30 @@ func resourceGithubRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fork": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"parent_full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func resourceGithubRepositoryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The destroy behaviour isn't stored on GitHub, start with the defaults.
+	d.Set("archive_on_destroy", false)
+	d.Set("deletion_protection", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGithubRepositoryObject(d *schema.ResourceData) *github.Repository {
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -175,6 +240,40 @@ func resourceGithubRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	repoReq := resourceGithubRepositoryObject(d)
+
+	// A fork takes the name of its source, the update renames it and applies
+	// the remaining settings once it is available.
+	if v, ok := d.GetOk("fork_from"); ok {
+		name, err := createGithubRepositoryFork(client, meta.(*Organization).name, v.(string))
+		if err != nil {
+			return err
+		}
+		d.SetId(name)
+
+		return resourceGithubRepositoryUpdate(d, meta)
+	}
+
+	if v, ok := d.GetOk("template"); ok {
+		template := v.([]interface{})[0].(map[string]interface{})
+		templateOwner := template["owner"].(string)
+		templateRepo := template["repository"].(string)
+
+		log.Printf("[DEBUG] create github repository %s/%s from template %s/%s",
+			meta.(*Organization).name, *repoReq.Name, templateOwner, templateRepo)
+		repo, _, err := createGithubRepositoryFromTemplate(client, templateOwner, templateRepo, &templateRepoRequest{
+			Owner:       &meta.(*Organization).name,
+			Name:        repoReq.Name,
+			Description: repoReq.Description,
+			Private:     repoReq.Private,
+		})
+		if err != nil {
+			return err
+		}
+		d.SetId(*repo.Name)
+
+		return resourceGithubRepositoryUpdate(d, meta)
+	}
+
 	log.Printf("[DEBUG] create github repository %s/%s", meta.(*Organization).name, *repoReq.Name)
 	repo, _, err := client.Repositories.Create(ctx, meta.(*Organization).name, repoReq)
 	if err != nil {
@@ -198,7 +297,7 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	repoName := d.Id()
 
 	log.Printf("[DEBUG] read github repository %s/%s", meta.(*Organization).name, repoName)
-	repo, resp, err := client.Repositories.Get(context.TODO(), meta.(*Organization).name, repoName)
+	repo, resp, err := getGithubRepository(client, meta.(*Organization).name, repoName)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf(
@@ -212,7 +311,20 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	d.Set("name", repoName)
+	// Requests for a transferred repository are redirected to its new owner.
+	// Removing it from the state would create a new repository in its place,
+	// so it is kept as it was last read, and changing or destroying it doesn't
+	// touch the repository at its new owner.
+	if newOwner := repo.GetOwner().GetLogin(); !strings.EqualFold(newOwner, meta.(*Organization).name) {
+		log.Printf("[WARN] github repository %s/%s was transferred to %s, keeping its last known state",
+			meta.(*Organization).name, repoName, newOwner)
+		return nil
+	}
+
+	// GitHub redirects requests for the previous name of a renamed repository,
+	// so the ID follows renames made outside of Terraform.
+	d.SetId(repo.GetName())
+	d.Set("name", repo.Name)
 	d.Set("description", repo.Description)
 	d.Set("homepage_url", repo.Homepage)
 	d.Set("private", repo.Private)
@@ -231,6 +343,24 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("http_clone_url", repo.CloneURL)
 	d.Set("archived", repo.Archived)
 	d.Set("topics", flattenStringList(repo.Topics))
+	d.Set("is_template", repo.IsTemplate)
+	d.Set("fork", repo.Fork)
+	d.Set("parent_full_name", repo.GetParent().GetFullName())
+	d.Set("source_full_name", repo.GetSource().GetFullName())
+
+	// Read where the repository comes from, so that importing a fork or a
+	// repository generated from a template doesn't replace it.
+	if repo.GetFork() {
+		d.Set("fork_from", repo.GetParent().GetFullName())
+	}
+	if t := repo.TemplateRepository; t != nil {
+		d.Set("template", []interface{}{
+			map[string]interface{}{
+				"owner":      t.GetOwner().GetLogin(),
+				"repository": t.GetName(),
+			},
+		})
+	}
 	return nil
 }
 
@@ -248,17 +378,70 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	repoName := d.Id()
-	log.Printf("[DEBUG] update github repository %s/%s", meta.(*Organization).name, repoName)
-	repo, _, err := client.Repositories.Edit(ctx, meta.(*Organization).name, repoName, repoReq)
+
+	newOwner, err := githubRepositoryTransferredTo(client, meta.(*Organization).name, repoName)
 	if err != nil {
 		return err
 	}
+	if newOwner != "" {
+		return fmt.Errorf("Repository %s/%s was transferred to %s and can't be changed here. Remove it from the state "+
+			"with `terraform state rm` and import it with a provider configured for %s to keep managing it.",
+			meta.(*Organization).name, repoName, newOwner, newOwner)
+	}
+
+	// GitHub rejects changes to archived repositories, so an archived
+	// repository is unarchived while its settings change and archived again
+	// afterwards.
+	o, n := d.GetChange("archived")
+	wasArchived, archive := o.(bool), n.(bool)
+	if wasArchived && archive && !resourceGithubRepositoryHasSettingsChange(d) {
+		return resourceGithubRepositoryRead(d, meta)
+	}
+
+	if wasArchived {
+		log.Printf("[DEBUG] unarchive github repository %s/%s", meta.(*Organization).name, repoName)
+		if err := setGithubRepositoryArchived(client, meta.(*Organization).name, repoName, false); err != nil {
+			return err
+		}
+	}
+
+	// A failed change mustn't leave the repository unarchived.
+	restoreArchived := func(name string, err error) error {
+		if wasArchived {
+			log.Printf("[DEBUG] archive github repository %s/%s again", meta.(*Organization).name, name)
+			if aErr := setGithubRepositoryArchived(client, meta.(*Organization).name, name, true); aErr != nil {
+				log.Printf("[WARN] failed to archive github repository %s/%s again: %s", meta.(*Organization).name, name, aErr)
+			}
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] update github repository %s/%s", meta.(*Organization).name, repoName)
+	isTemplate := d.Get("is_template").(bool)
+	repoReq.Archived = github.Bool(false)
+	repo, _, err := editGithubRepository(client, meta.(*Organization).name, repoName, &repository{
+		Repository: repoReq,
+		IsTemplate: &isTemplate,
+	})
+	if err != nil {
+		return restoreArchived(repoName, err)
+	}
+	if repoName != *repo.Name {
+		log.Printf("[DEBUG] renamed github repository %s/%s to %s", meta.(*Organization).name, repoName, *repo.Name)
+	}
 	d.SetId(*repo.Name)
 
 	if d.HasChange("topics") {
 		topics := repoReq.Topics
 		_, _, err = client.Repositories.ReplaceAllTopics(ctx, meta.(*Organization).name, *repo.Name, topics)
 		if err != nil {
+			return restoreArchived(*repo.Name, err)
+		}
+	}
+
+	if archive {
+		log.Printf("[DEBUG] archive github repository %s/%s", meta.(*Organization).name, *repo.Name)
+		if err := setGithubRepositoryArchived(client, meta.(*Organization).name, *repo.Name, true); err != nil {
 			return err
 		}
 	}
@@ -266,10 +449,51 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceGithubRepositoryRead(d, meta)
 }
 
+// resourceGithubRepositoryHasSettingsChange reports whether any setting
+// stored on GitHub changes, as opposed to the settings only used by Terraform.
+func resourceGithubRepositoryHasSettingsChange(d *schema.ResourceData) bool {
+	for _, k := range []string{
+		"name", "description", "homepage_url", "private", "has_issues", "has_projects",
+		"has_downloads", "has_wiki", "allow_merge_commit", "allow_squash_merge",
+		"allow_rebase_merge", "default_branch", "topics", "is_template",
+	} {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceGithubRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
 	repoName := d.Id()
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot destroy repository %s/%s: deletion_protection is enabled. "+
+			"Set deletion_protection to false and apply before destroying it.", meta.(*Organization).name, repoName)
+	}
+
+	newOwner, err := githubRepositoryTransferredTo(client, meta.(*Organization).name, repoName)
+	if err != nil {
+		return err
+	}
+	if newOwner != "" {
+		log.Printf("[WARN] github repository %s/%s was transferred to %s, removing it from state without deleting it",
+			meta.(*Organization).name, repoName, newOwner)
+		return nil
+	}
+
+	if d.Get("archive_on_destroy").(bool) {
+		if d.Get("archived").(bool) {
+			log.Printf("[DEBUG] github repository %s/%s is already archived, removing it from state", meta.(*Organization).name, repoName)
+			return nil
+		}
+
+		log.Printf("[DEBUG] archive github repository %s/%s instead of deleting it", meta.(*Organization).name, repoName)
+		return setGithubRepositoryArchived(client, meta.(*Organization).name, repoName, true)
+	}
+
 	log.Printf("[DEBUG] delete github repository %s/%s", meta.(*Organization).name, repoName)
-	_, err := client.Repositories.Delete(context.TODO(), meta.(*Organization).name, repoName)
+	_, err = client.Repositories.Delete(context.TODO(), meta.(*Organization).name, repoName)
 	return err
 }
@@ -11,11 +11,12 @@ func resourceGithubRepositoryCollaborator() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGithubRepositoryCollaboratorCreate,
 		Read:   resourceGithubRepositoryCollaboratorRead,
-		// editing repository collaborators are not supported by github api so forcing new on any changes
+		Update: resourceGithubRepositoryCollaboratorUpdate,
 		Delete: resourceGithubRepositoryCollaboratorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryRenameCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -26,14 +27,12 @@ func resourceGithubRepositoryCollaborator() *schema.Resource {
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"permission": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      "push",
-				ValidateFunc: validateValueFunc([]string{"pull", "push", "admin"}),
+				ValidateFunc: validateValueFunc([]string{"pull", "triage", "push", "maintain", "admin"}),
 			},
 		},
 	}
@@ -45,9 +44,11 @@ func resourceGithubRepositoryCollaboratorCreate(d *schema.ResourceData, meta int
 	r := d.Get("repository").(string)
 	p := d.Get("permission").(string)
 
-	_, err := client.Repositories.AddCollaborator(context.TODO(), meta.(*Organization).name, r, u,
-		&github.RepositoryAddCollaboratorOptions{Permission: p})
-
+	err := withGithubRepositoryUnarchived(client, meta.(*Organization).name, r, func() error {
+		_, err := client.Repositories.AddCollaborator(context.TODO(), meta.(*Organization).name, r, u,
+			&github.RepositoryAddCollaboratorOptions{Permission: p})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -81,14 +82,14 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 	opt := &github.ListCollaboratorsOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
 
 	for {
-		collaborators, resp, err := client.Repositories.ListCollaborators(context.TODO(), meta.(*Organization).name, r, opt)
+		collaborators, resp, err := listRepoCollaborators(client, meta.(*Organization).name, r, opt)
 		if err != nil {
 			return err
 		}
 
 		for _, c := range collaborators {
 			if *c.Login == u {
-				permName, err := getRepoPermission(c.Permissions)
+				permName, err := getCollaboratorPermission(c)
 				if err != nil {
 					return err
 				}
@@ -111,22 +112,62 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceGithubRepositoryCollaboratorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceGithubRepositoryCollaboratorUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
 	u := d.Get("username").(string)
 	r := d.Get("repository").(string)
+	p := d.Get("permission").(string)
 
-	// Delete any pending invitations
-	invitation, err := findRepoInvitation(client, meta.(*Organization).name, r, u)
-	if err != nil {
-		return err
-	} else if invitation != nil {
-		_, err = client.Repositories.DeleteInvitation(context.TODO(), meta.(*Organization).name, r, *invitation.ID)
-		return err
+	if d.HasChange("repository") {
+		o, _ := d.GetChange("repository")
+		renamed, err := isGithubRepositoryRenamed(client, meta.(*Organization).name, o.(string), r)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return moveGithubRepositoryResource(d, meta, o.(string), func() error {
+				return removeRepoCollaboratorOrInvitation(client, meta.(*Organization).name, o.(string), u)
+			}, resourceGithubRepositoryCollaboratorCreate)
+		}
 	}
 
-	_, err = client.Repositories.RemoveCollaborator(context.TODO(), meta.(*Organization).name, r, u)
-	return err
+	if d.HasChange("permission") {
+		err := withGithubRepositoryUnarchived(client, meta.(*Organization).name, r, func() error {
+			return updateRepoCollaboratorPermission(client, meta.(*Organization).name, r, u, p)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId(buildTwoPartID(&r, &u))
+
+	return resourceGithubRepositoryCollaboratorRead(d, meta)
+}
+
+func resourceGithubRepositoryCollaboratorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	u := d.Get("username").(string)
+	r := d.Get("repository").(string)
+
+	return removeRepoCollaboratorOrInvitation(client, meta.(*Organization).name, r, u)
+}
+
+// removeRepoCollaboratorOrInvitation removes a collaborator from a
+// repository, or their pending invitation.
+func removeRepoCollaboratorOrInvitation(client *github.Client, owner, repo, username string) error {
+	return withGithubRepositoryUnarchived(client, owner, repo, func() error {
+		// Delete any pending invitations
+		invitation, err := findRepoInvitation(client, owner, repo, username)
+		if err != nil {
+			return err
+		} else if invitation != nil {
+			_, err = client.Repositories.DeleteInvitation(context.TODO(), owner, repo, *invitation.ID)
+			return err
+		}
+
+		_, err = client.Repositories.RemoveCollaborator(context.TODO(), owner, repo, username)
+		return err
+	})
 }
 
 func findRepoInvitation(client *github.Client, owner string, repo string, collaborator string) (*github.RepositoryInvitation, error) {
@@ -150,3 +191,27 @@ func findRepoInvitation(client *github.Client, owner string, repo string, collab
 	}
 	return nil, nil
 }
+
+// updateRepoCollaboratorPermission changes the permission of an existing
+// collaborator without removing them from the repository. Pending invitations
+// are updated in place so that the user doesn't receive a new invitation.
+func updateRepoCollaboratorPermission(client *github.Client, owner string, repo string, collaborator string, permission string) error {
+	invitation, err := findRepoInvitation(client, owner, repo, collaborator)
+	if err != nil {
+		return err
+	} else if invitation != nil {
+		invitationPermission, err := getInvitationPermissionName(permission)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = client.Repositories.UpdateInvitation(context.TODO(), owner, repo, *invitation.ID, invitationPermission)
+		return err
+	}
+
+	// The add collaborator endpoint also updates the permission of users who
+	// are already collaborators.
+	_, err = client.Repositories.AddCollaborator(context.TODO(), owner, repo, collaborator,
+		&github.RepositoryAddCollaboratorOptions{Permission: permission})
+	return err
+}
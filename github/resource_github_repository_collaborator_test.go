@@ -24,13 +24,88 @@ func TestAccGithubRepositoryCollaborator_basic(t *testing.T) {
 				Config: testAccGithubRepositoryCollaboratorConfig(repoName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGithubRepositoryCollaboratorExists("github_repository_collaborator.test_repo_collaborator"),
-					testAccCheckGithubRepositoryCollaboratorPermission("github_repository_collaborator.test_repo_collaborator"),
+					testAccCheckGithubRepositoryCollaboratorPermission("github_repository_collaborator.test_repo_collaborator", expectedPermission),
 				),
 			},
 		},
 	})
 }
 
+func TestAccGithubRepositoryCollaborator_update(t *testing.T) {
+	repoName := fmt.Sprintf("tf-acc-test-collab-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryCollaboratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCollaboratorPermissionConfig(repoName, "push"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryCollaboratorExists("github_repository_collaborator.test_repo_collaborator"),
+					testAccCheckGithubRepositoryCollaboratorPermission("github_repository_collaborator.test_repo_collaborator", "push"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCollaboratorPermissionConfig(repoName, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryCollaboratorExists("github_repository_collaborator.test_repo_collaborator"),
+					testAccCheckGithubRepositoryCollaboratorPermission("github_repository_collaborator.test_repo_collaborator", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryCollaborator_archivedRepository(t *testing.T) {
+	repoName := fmt.Sprintf("tf-acc-test-collab-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryCollaboratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCollaboratorArchivedConfig(repoName, "push"),
+			},
+			{
+				Config: testAccGithubRepositoryCollaboratorArchivedConfig(repoName, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryCollaboratorPermission("github_repository_collaborator.test_repo_collaborator", "admin"),
+					resource.TestCheckResourceAttr("github_repository.test", "archived", "true"),
+				),
+			},
+			{
+				// Removing the collaborator from the archived repository revokes its access.
+				Config: testAccGithubRepositoryCollaboratorArchivedConfig(repoName, ""),
+				Check: func(s *terraform.State) error {
+					org := testAccProvider.Meta().(*Organization)
+					invitation, err := findRepoInvitation(org.client, org.name, repoName, testCollaborator)
+					if err != nil {
+						return err
+					}
+					isCollaborator, _, err := org.client.Repositories.IsCollaborator(context.TODO(), org.name, repoName, testCollaborator)
+					if err != nil {
+						return err
+					}
+					if invitation != nil || isCollaborator {
+						return fmt.Errorf("%s still has access to %s", testCollaborator, repoName)
+					}
+
+					repo, _, err := org.client.Repositories.Get(context.TODO(), org.name, repoName)
+					if err != nil {
+						return err
+					}
+					if !repo.GetArchived() {
+						return fmt.Errorf("%s wasn't archived again", repoName)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccGithubRepositoryCollaborator_importBasic(t *testing.T) {
 	repoName := fmt.Sprintf("tf-acc-test-collab-%s", acctest.RandString(5))
 
@@ -113,7 +188,55 @@ func testAccCheckGithubRepositoryCollaboratorExists(n string) resource.TestCheck
 	}
 }
 
-func testAccCheckGithubRepositoryCollaboratorPermission(n string) resource.TestCheckFunc {
+func TestAccCheckGetInvitationPermissionName(t *testing.T) {
+	cases := map[string]string{
+		"pull":     "read",
+		"triage":   "triage",
+		"push":     "write",
+		"maintain": "maintain",
+		"admin":    "admin",
+	}
+
+	for permission, expected := range cases {
+		actual, err := getInvitationPermissionName(permission)
+		if err != nil {
+			t.Fatalf("Expected no error getting invitation permission for %s, actual: %s", permission, err)
+		}
+		if actual != expected {
+			t.Fatalf("Expected invitation permission %s for %s, actual: %s", expected, permission, actual)
+		}
+	}
+
+	if _, err := getInvitationPermissionName("invalid"); err == nil {
+		t.Fatalf("Expected an error getting invitation permission for invalid")
+	}
+}
+
+func TestAccCheckGetRoleNamePermission(t *testing.T) {
+	cases := map[string]string{
+		"read":     "pull",
+		"triage":   "triage",
+		"write":    "push",
+		"maintain": "maintain",
+		"admin":    "admin",
+	}
+
+	for roleName, expected := range cases {
+		actual, err := getRoleNamePermission(roleName)
+		if err != nil {
+			t.Fatalf("Expected no error getting permission for role %s, actual: %s", roleName, err)
+		}
+		if actual != expected {
+			t.Fatalf("Expected permission %s for role %s, actual: %s", expected, roleName, actual)
+		}
+	}
+
+	if _, err := getRoleNamePermission("invalid"); err == nil {
+		t.Fatalf("Expected an error getting permission for role invalid")
+	}
+}
+
+func testAccCheckGithubRepositoryCollaboratorPermission(n, expectedPermission string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -166,3 +289,40 @@ resource "github_repository" "test" {
   }
 `, repoName, testCollaborator, expectedPermission)
 }
+
+func testAccGithubRepositoryCollaboratorPermissionConfig(repoName, permission string) string {
+	return fmt.Sprintf(`
+  resource "github_repository" "test" {
+    name = "%s"
+  }
+
+  resource "github_repository_collaborator" "test_repo_collaborator" {
+    repository = "${github_repository.test.name}"
+    username = "%s"
+    permission = "%s"
+  }
+`, repoName, testCollaborator, permission)
+}
+
+// testAccGithubRepositoryCollaboratorArchivedConfig adds a collaborator to an
+// archived repository, leaving the collaborator out when no permission is
+// given.
+func testAccGithubRepositoryCollaboratorArchivedConfig(repoName, permission string) string {
+	config := fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+  archived = true
+}
+`, repoName)
+	if permission == "" {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "github_repository_collaborator" "test_repo_collaborator" {
+  repository = "${github_repository.test.name}"
+  username = "%s"
+  permission = "%s"
+}
+`, testCollaborator, permission)
+}
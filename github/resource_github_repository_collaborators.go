@@ -0,0 +1,394 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositoryCollaborators() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCollaboratorsCreate,
+		Read:   resourceGithubRepositoryCollaboratorsRead,
+		Update: resourceGithubRepositoryCollaboratorsUpdate,
+		Delete: resourceGithubRepositoryCollaboratorsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGithubRepositoryRenameCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "push",
+							ValidateFunc: validateValueFunc([]string{"pull", "triage", "push", "maintain", "admin"}),
+						},
+					},
+				},
+			},
+			"team": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "pull",
+							ValidateFunc: validateValueFunc([]string{"pull", "triage", "push", "maintain", "admin"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta interface{}) error {
+	r := d.Get("repository").(string)
+
+	// Anything already granted on the repository is treated as the previous
+	// state, so that the first apply also removes unmanaged collaborators.
+	users, err := listRepoUserPermissions(meta, r)
+	if err != nil {
+		return err
+	}
+	teams, err := listRepoTeamPermissions(meta, r)
+	if err != nil {
+		return err
+	}
+
+	err = withGithubRepositoryUnarchived(meta.(*Organization).client, meta.(*Organization).name, r, func() error {
+		if err := updateRepoUserPermissions(meta, r, users, d.Get("user").(*schema.Set).List()); err != nil {
+			return err
+		}
+		return updateRepoTeamPermissions(meta, r, teams, d.Get("team").(*schema.Set).List())
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(r)
+
+	return resourceGithubRepositoryCollaboratorsRead(d, meta)
+}
+
+func resourceGithubRepositoryCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	r := d.Id()
+
+	_, resp, err := client.Repositories.Get(context.TODO(), meta.(*Organization).name, r)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing collaborators of %s/%s from state because the repository no longer exists in github",
+				meta.(*Organization).name, r)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	users, err := listRepoUserPermissions(meta, r)
+	if err != nil {
+		return err
+	}
+	teams, err := listRepoTeamPermissions(meta, r)
+	if err != nil {
+		return err
+	}
+
+	d.Set("repository", r)
+	d.Set("user", users)
+	d.Set("team", teams)
+	return nil
+}
+
+func resourceGithubRepositoryCollaboratorsUpdate(d *schema.ResourceData, meta interface{}) error {
+	r := d.Get("repository").(string)
+
+	if d.HasChange("repository") {
+		o, _ := d.GetChange("repository")
+		renamed, err := isGithubRepositoryRenamed(meta.(*Organization).client, meta.(*Organization).name, o.(string), r)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			oldUsers, _ := d.GetChange("user")
+			oldTeams, _ := d.GetChange("team")
+			return moveGithubRepositoryResource(d, meta, o.(string), func() error {
+				return withGithubRepositoryUnarchived(meta.(*Organization).client, meta.(*Organization).name, o.(string), func() error {
+					if err := updateRepoUserPermissions(meta, o.(string), oldUsers.(*schema.Set).List(), nil); err != nil {
+						return err
+					}
+					return updateRepoTeamPermissions(meta, o.(string), oldTeams.(*schema.Set).List(), nil)
+				})
+			}, resourceGithubRepositoryCollaboratorsCreate)
+		}
+	}
+
+	if d.HasChange("user") || d.HasChange("team") {
+		err := withGithubRepositoryUnarchived(meta.(*Organization).client, meta.(*Organization).name, r, func() error {
+			if d.HasChange("user") {
+				o, n := d.GetChange("user")
+				if err := updateRepoUserPermissions(meta, r, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+					return err
+				}
+			}
+
+			if d.HasChange("team") {
+				o, n := d.GetChange("team")
+				if err := updateRepoTeamPermissions(meta, r, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId(r)
+
+	return resourceGithubRepositoryCollaboratorsRead(d, meta)
+}
+
+func resourceGithubRepositoryCollaboratorsDelete(d *schema.ResourceData, meta interface{}) error {
+	r := d.Get("repository").(string)
+
+	return withGithubRepositoryUnarchived(meta.(*Organization).client, meta.(*Organization).name, r, func() error {
+		if err := updateRepoUserPermissions(meta, r, d.Get("user").(*schema.Set).List(), nil); err != nil {
+			return err
+		}
+		return updateRepoTeamPermissions(meta, r, d.Get("team").(*schema.Set).List(), nil)
+	})
+}
+
+// listRepoUserPermissions returns the direct collaborators of a repository,
+// including users who have been invited but have not yet accepted, in the
+// format of the `user` attribute.
+func listRepoUserPermissions(meta interface{}, repo string) ([]interface{}, error) {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	users := []interface{}{}
+
+	invOpt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		invitations, resp, err := client.Repositories.ListInvitations(context.TODO(), owner, repo, invOpt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range invitations {
+			permName, err := getInvitationPermission(i)
+			if err != nil {
+				return nil, err
+			}
+
+			users = append(users, map[string]interface{}{
+				"username":   i.Invitee.GetLogin(),
+				"permission": permName,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		invOpt.Page = resp.NextPage
+	}
+
+	opt := &github.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for {
+		collaborators, resp, err := listRepoCollaborators(client, owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range collaborators {
+			permName, err := getCollaboratorPermission(c)
+			if err != nil {
+				return nil, err
+			}
+
+			users = append(users, map[string]interface{}{
+				"username":   c.GetLogin(),
+				"permission": permName,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return users, nil
+}
+
+// listRepoTeamPermissions returns the teams with access to a repository in
+// the format of the `team` attribute.
+func listRepoTeamPermissions(meta interface{}, repo string) ([]interface{}, error) {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	teams := []interface{}{}
+
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		repoTeams, resp, err := listRepoTeams(client, owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range repoTeams {
+			permission, err := getTeamPermission(t)
+			if err != nil {
+				return nil, err
+			}
+
+			teams = append(teams, map[string]interface{}{
+				"team_id":    fromGithubID(t.ID),
+				"permission": permission,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return teams, nil
+}
+
+// updateRepoUserPermissions removes the users in o that are missing from n,
+// cancelling their invitation if it is still pending, and grants every user
+// in n its permission.
+func updateRepoUserPermissions(meta interface{}, repo string, o, n []interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+
+	current := make(map[string]string)
+	for _, u := range o {
+		user := u.(map[string]interface{})
+		current[user["username"].(string)] = user["permission"].(string)
+	}
+	desired := make(map[string]string)
+	for _, u := range n {
+		user := u.(map[string]interface{})
+		desired[user["username"].(string)] = user["permission"].(string)
+	}
+
+	for u := range current {
+		if _, ok := desired[u]; ok {
+			continue
+		}
+
+		invitation, err := findRepoInvitation(client, owner, repo, u)
+		if err != nil {
+			return err
+		} else if invitation != nil {
+			log.Printf("[DEBUG] Deleting invitation of collaborator: %s/%s (%s)", owner, repo, u)
+			_, err = client.Repositories.DeleteInvitation(context.TODO(), owner, repo, *invitation.ID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing collaborator: %s/%s (%s)", owner, repo, u)
+		_, err = client.Repositories.RemoveCollaborator(context.TODO(), owner, repo, u)
+		if err != nil {
+			return err
+		}
+	}
+
+	for u, p := range desired {
+		if c, ok := current[u]; ok {
+			if c == p {
+				continue
+			}
+
+			log.Printf("[DEBUG] Updating collaborator: %s/%s (%s: %s)", owner, repo, u, p)
+			if err := updateRepoCollaboratorPermission(client, owner, repo, u, p); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Adding collaborator: %s/%s (%s: %s)", owner, repo, u, p)
+		_, err := client.Repositories.AddCollaborator(context.TODO(), owner, repo, u,
+			&github.RepositoryAddCollaboratorOptions{Permission: p})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateRepoTeamPermissions removes the teams in o that are missing from n
+// and grants every team in n its permission.
+func updateRepoTeamPermissions(meta interface{}, repo string, o, n []interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+
+	current := make(map[string]string)
+	for _, t := range o {
+		team := t.(map[string]interface{})
+		current[team["team_id"].(string)] = team["permission"].(string)
+	}
+	desired := make(map[string]string)
+	for _, t := range n {
+		team := t.(map[string]interface{})
+		desired[team["team_id"].(string)] = team["permission"].(string)
+	}
+
+	for t := range current {
+		if _, ok := desired[t]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing team from repository: %s/%s (%s)", owner, repo, t)
+		_, err := client.Organizations.RemoveTeamRepo(context.TODO(), toGithubID(t), owner, repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	for t, p := range desired {
+		if current[t] == p {
+			continue
+		}
+
+		log.Printf("[DEBUG] Adding team to repository: %s/%s (%s: %s)", owner, repo, t, p)
+		_, err := client.Organizations.AddTeamRepo(context.TODO(), toGithubID(t), owner, repo,
+			&github.OrganizationAddTeamRepoOptions{Permission: p})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepositoryCollaborators_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-collabs-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryCollaboratorsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCollaboratorsConfig(randString, repoName, "push", "pull"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_collaborators.test_repo_collaborators", "user.#", "1"),
+					resource.TestCheckResourceAttr("github_repository_collaborators.test_repo_collaborators", "team.#", "1"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCollaboratorsConfig(randString, repoName, "admin", "push"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_collaborators.test_repo_collaborators", "user.#", "1"),
+					resource.TestCheckResourceAttr("github_repository_collaborators.test_repo_collaborators", "team.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryCollaborators_importBasic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-collabs-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryCollaboratorsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCollaboratorsConfig(randString, repoName, "push", "pull"),
+			},
+			{
+				ResourceName:      "github_repository_collaborators.test_repo_collaborators",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryCollaboratorsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_repository_collaborators" {
+			continue
+		}
+
+		o := testAccProvider.Meta().(*Organization).name
+		opt := &github.ListCollaboratorsOptions{Affiliation: "direct"}
+		collaborators, resp, err := conn.Repositories.ListCollaborators(context.TODO(), o, rs.Primary.ID, opt)
+		if err == nil {
+			if len(collaborators) > 0 {
+				return fmt.Errorf("Repository still has %d collaborators", len(collaborators))
+			}
+			continue
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGithubRepositoryCollaboratorsConfig(randString, repoName, userPermission, teamPermission string) string {
+	return fmt.Sprintf(`
+  resource "github_repository" "test" {
+    name = "%s"
+  }
+
+  resource "github_team" "test_team" {
+    name = "tf-acc-test-collaborators-%s"
+    description = "Terraform acc test group"
+  }
+
+  resource "github_repository_collaborators" "test_repo_collaborators" {
+    repository = "${github_repository.test.name}"
+
+    user {
+      username = "%s"
+      permission = "%s"
+    }
+
+    team {
+      team_id = "${github_team.test_team.id}"
+      permission = "%s"
+    }
+  }
+`, repoName, randString, testCollaborator, userPermission, teamPermission)
+}
@@ -2,7 +2,10 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,17 +15,42 @@ func resourceGithubRepositoryDeployKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGithubRepositoryDeployKeyCreate,
 		Read:   resourceGithubRepositoryDeployKeyRead,
-		// Deploy keys are defined immutable in the API. Updating results in force new.
+		// Deploy keys are defined immutable in the API. Updating results in force new,
+		// except for following a renamed repository.
+		Update: resourceGithubRepositoryDeployKeyUpdate,
 		Delete: resourceGithubRepositoryDeployKeyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryDeployKeyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"generate_key_type"},
+				ValidateFunc:     validateSSHPublicKey,
+				DiffSuppressFunc: suppressEquivalentSSHPublicKeys,
+			},
+			"generate_key_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key"},
+				ValidateFunc:  validateValueFunc([]string{"ed25519", "rsa"}),
+			},
+			"rsa_bits": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDefaultRSABits,
+			},
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"read_only": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -33,23 +61,58 @@ func resourceGithubRepositoryDeployKey() *schema.Resource {
 			"repository": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"title": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func resourceGithubRepositoryDeployKeyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("rsa_bits"); ok && d.NewValueKnown("generate_key_type") {
+		if d.Get("generate_key_type").(string) != "rsa" {
+			return fmt.Errorf("rsa_bits can only be set when generate_key_type is \"rsa\"")
+		}
+	}
+	return resourceGithubRepositoryRenameCustomizeDiff(d, meta)
+}
+
 func resourceGithubRepositoryDeployKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
 
 	repo := d.Get("repository").(string)
 
 	k := d.Get("key").(string)
+	if keyType, ok := d.GetOk("generate_key_type"); ok {
+		bits := d.Get("rsa_bits").(int)
+		if bits == 0 {
+			bits = defaultRSABits
+		}
+		publicKey, privateKey, err := generateSSHKeyPair(keyType.(string), bits)
+		if err != nil {
+			return err
+		}
+		k = publicKey
+		d.Set("private_key", privateKey)
+	} else if k == "" {
+		return fmt.Errorf("one of key or generate_key_type must be set")
+	}
+
 	t := d.Get("title").(string)
 	r := d.Get("read_only").(bool)
 	key := &github.Key{
@@ -59,6 +122,10 @@ func resourceGithubRepositoryDeployKeyCreate(d *schema.ResourceData, meta interf
 	}
 
 	owner := meta.(*Organization).name
+	if err := checkGithubRepositoryWritable(client, owner, repo); err != nil {
+		return err
+	}
+
 	resultKey, _, err := client.Repositories.CreateKey(context.TODO(), owner, repo, key)
 
 	if err != nil {
@@ -84,12 +151,27 @@ func resourceGithubRepositoryDeployKeyRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	key, _, err := client.Repositories.GetKey(context.TODO(), owner, repo, i)
+	key, resp, err := getDeployKey(client, owner, repo, i)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing deploy key %s from state because it no longer exists in github", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	fingerprint, err := sshPublicKeyFingerprint(key.GetKey())
 	if err != nil {
 		return err
 	}
 
 	d.Set("key", key.Key)
+	d.Set("fingerprint", fingerprint)
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
+	d.Set("verified", key.Verified)
 	d.Set("read_only", key.ReadOnly)
 	d.Set("repository", repo)
 	d.Set("title", key.Title)
@@ -97,6 +179,27 @@ func resourceGithubRepositoryDeployKeyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceGithubRepositoryDeployKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repo := d.Get("repository").(string)
+	oldRepo, id := parseTwoPartID(d.Id())
+	renamed, err := isGithubRepositoryRenamed(client, owner, oldRepo, repo)
+	if err != nil {
+		return err
+	}
+	if !renamed {
+		return moveGithubRepositoryResource(d, meta, oldRepo, func() error {
+			return resourceGithubRepositoryDeployKeyDelete(d, meta)
+		}, resourceGithubRepositoryDeployKeyCreate)
+	}
+
+	d.SetId(buildTwoPartID(&repo, &id))
+
+	return resourceGithubRepositoryDeployKeyRead(d, meta)
+}
+
 func resourceGithubRepositoryDeployKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
 
@@ -108,10 +211,10 @@ func resourceGithubRepositoryDeployKeyDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	_, err = client.Repositories.DeleteKey(context.TODO(), owner, repo, i)
-	if err != nil {
+	// A deploy key gives access to the repository, so it is removed even
+	// when the repository is archived.
+	return withGithubRepositoryUnarchived(client, owner, repo, func() error {
+		_, err := client.Repositories.DeleteKey(context.TODO(), owner, repo, i)
 		return err
-	}
-
-	return err
+	})
 }
@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -27,12 +29,120 @@ func TestAccGithubRepositoryDeployKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "repository", repositoryName),
 					resource.TestCheckResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "key", testAccGithubRepositoryDeployKeytestDeployKey),
 					resource.TestCheckResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "title", "title"),
+					resource.TestCheckResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "fingerprint", "SHA256:e3cf0TouTqwkbNop0P4Ti0LUrmvRUpYV/G3ycjJOa4o"),
+					resource.TestCheckResourceAttrSet("github_repository_deploy_key.test_repo_deploy_key", "created_at"),
+					resource.TestCheckResourceAttrSet("github_repository_deploy_key.test_repo_deploy_key", "verified"),
 				),
 			},
+			{
+				// A comment and surrounding whitespace don't change the key.
+				Config:   testAccGithubRepositoryDeployKeyConfigWithKey(repositoryName, "  "+testAccGithubRepositoryDeployKeytestDeployKey+" someone@example.com "),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryDeployKey_generateKey(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repositoryName := fmt.Sprintf("acctest-%s", rs)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDeployKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryDeployKeyGenerateConfig(repositoryName, "ed25519"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryDeployKeyExists("github_repository_deploy_key.test_repo_deploy_key"),
+					resource.TestMatchResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "key", regexp.MustCompile("^ssh-ed25519 ")),
+					resource.TestMatchResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "private_key", regexp.MustCompile("BEGIN OPENSSH PRIVATE KEY")),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryDeployKeyGenerateConfig(repositoryName, "rsa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryDeployKeyExists("github_repository_deploy_key.test_repo_deploy_key"),
+					resource.TestMatchResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "key", regexp.MustCompile("^ssh-rsa ")),
+					resource.TestMatchResourceAttr("github_repository_deploy_key.test_repo_deploy_key", "private_key", regexp.MustCompile("BEGIN RSA PRIVATE KEY")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryDeployKey_invalidKey(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repositoryName := fmt.Sprintf("acctest-%s", rs)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGithubRepositoryDeployKeyConfigWithKey(repositoryName, "ssh-rsa invalid"),
+				ExpectError: regexp.MustCompile("must be an OpenSSH public key"),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryDeployKey_rsaBitsWithoutRSA(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repositoryName := fmt.Sprintf("acctest-%s", rs)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGithubRepositoryDeployKeyGenerateConfig(repositoryName, "ed25519") + "\n" + testAccGithubRepositoryDeployKeyRSABitsConfig,
+				ExpectError: regexp.MustCompile(`rsa_bits can only be set when generate_key_type is "rsa"`),
+			},
 		},
 	})
 }
 
+// Deploy keys created before rsa_bits existed have no rsa_bits in their
+// state, which must not replace them.
+func TestAccGithubRepositoryDeployKey_rsaBitsUpgrade(t *testing.T) {
+	cases := []map[string]interface{}{
+		{
+			"key":        testAccGithubRepositoryDeployKeytestDeployKey,
+			"read_only":  "false",
+			"repository": "test-repo",
+			"title":      "title",
+		},
+		{
+			"generate_key_type": "rsa",
+			"read_only":         "false",
+			"repository":        "test-repo",
+			"title":             "title",
+		},
+	}
+
+	for _, tc := range cases {
+		attributes := map[string]string{"id": "test-repo:1"}
+		for k, v := range tc {
+			attributes[k] = v.(string)
+		}
+		if _, ok := tc["key"]; !ok {
+			attributes["key"] = testAccGithubRepositoryDeployKeytestDeployKey
+		}
+		state := &terraform.InstanceState{ID: "test-repo:1", Attributes: attributes}
+
+		raw, err := config.NewRawConfig(tc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := resourceGithubRepositoryDeployKey().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff.RequiresNew() {
+			t.Fatalf("Expected no replacement for %v, got diff: %#v", tc, diff)
+		}
+	}
+}
+
 func TestAccGithubRepositoryDeployKey_importBasic(t *testing.T) {
 	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	repositoryName := fmt.Sprintf("acctest-%s", rs)
@@ -110,6 +220,10 @@ func testAccCheckGithubRepositoryDeployKeyExists(n string) resource.TestCheckFun
 const testAccGithubRepositoryDeployKeytestDeployKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQDnDk1liOxXwE27fjOVVHl6RNVgQznGqGIfhsoa5QNfLOcoWJR3EIv44dSUx1GSvxQ7uR9qBY/i/SEdAbKdupo3Ru5sykc0GqaMRVys+Cin/Lgnl6+ntmTZOudNjIbz10Vfu/dKmexSzqlD3XWzPGXRI5WyKWzvc2XKjRdfnOOzogJpqJ5kh/CN0ZhCzBPTu/b4mJl2ionTEzEeLK2g4Re4IuU/dGoyf0LGLidjmqhSY7dQtL+mfte9m3x/BQTrDf0+AW3kGWXR8EL0EyIJ2HRtHW67YnoOcTAFK0hDCuKgvt78rqdUQ2bVjcsIhNqnvQMPf3ZeZ5bP2JqB9zKaFl8uaRJv+TdxEeFTkgnbYb85M+aBggBYr6xxeb24g7WlU0iPxJ8GmjvCizxe2I1DOJDRDozn1sinKjapNRdJy00iuo46TJC5Wgmid0vnMJ7SMZtubz+btxhoFLt4F4U2JnILaYG4/buJg4H/GkqmkE8G3hr4b4mgsFXBtBFgK6uCTFQSvvV7TyyWkZxHL6DRCxL/Dp0bSj+EM8Tw1K304EvkBEO3rMyvPs4nXL7pepyKWalmUI8U4Qp2xMXSq7fmfZY55osb03MUAtKl0wJ/ykyKOwYWeLbubSVcc6VPx5bXZmnM5bTcZdYW9+vNt86X2F2b0h/sIkGNEPpqQQBzElY+fQ=="
 
 func testAccGithubRepositoryDeployKeyConfig(name string) string {
+	return testAccGithubRepositoryDeployKeyConfigWithKey(name, testAccGithubRepositoryDeployKeytestDeployKey)
+}
+
+func testAccGithubRepositoryDeployKeyConfigWithKey(name, key string) string {
 	return fmt.Sprintf(`
   resource "github_repository" "test_repo" {
 		name = "%s"
@@ -121,5 +235,30 @@ func testAccGithubRepositoryDeployKeyConfig(name string) string {
     repository = "${github_repository.test_repo.name}"
     title = "title"
   }
-`, name, testAccGithubRepositoryDeployKeytestDeployKey)
+`, name, key)
+}
+
+const testAccGithubRepositoryDeployKeyRSABitsConfig = `
+	resource "github_repository_deploy_key" "test_rsa_bits" {
+    generate_key_type = "ed25519"
+    rsa_bits = 2048
+    read_only = "false"
+    repository = "${github_repository.test_repo.name}"
+    title = "rsa_bits"
+  }
+`
+
+func testAccGithubRepositoryDeployKeyGenerateConfig(name, keyType string) string {
+	return fmt.Sprintf(`
+  resource "github_repository" "test_repo" {
+		name = "%s"
+	}
+
+	resource "github_repository_deploy_key" "test_repo_deploy_key" {
+    generate_key_type = "%s"
+    read_only = "false"
+    repository = "${github_repository.test_repo.name}"
+    title = "title"
+  }
+`, name, keyType)
 }
@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositorySourceImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositorySourceImportCreate,
+		Read:   resourceGithubRepositorySourceImportRead,
+		// An import runs once. Updating results in force new.
+		Delete: resourceGithubRepositorySourceImportDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vcs_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vcs": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateValueFunc([]string{"git", "subversion", "mercurial", "tfvc"}),
+			},
+			"tfvc_project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"authors_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"has_large_files": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"large_files_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"large_files_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositorySourceImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+
+	if err := checkGithubRepositoryWritable(client, owner, repoName); err != nil {
+		return err
+	}
+
+	imp := &github.Import{
+		VCSURL: github.String(d.Get("vcs_url").(string)),
+	}
+	if v, ok := d.GetOk("vcs"); ok {
+		imp.VCS = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("tfvc_project"); ok {
+		imp.TFVCProject = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("credentials"); ok {
+		credentials := v.([]interface{})[0].(map[string]interface{})
+		imp.VCSUsername = github.String(credentials["username"].(string))
+		imp.VCSPassword = github.String(credentials["password"].(string))
+	}
+
+	log.Printf("[DEBUG] start import of %s into github repository %s/%s", imp.GetVCSURL(), owner, repoName)
+	_, _, err := client.Migrations.StartImport(context.TODO(), owner, repoName, imp)
+	if err != nil {
+		return err
+	}
+	d.SetId(repoName)
+
+	_, err = waitForGithubRepositoryImport(client, owner, repoName, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// The import stays in the state as tainted, so that the next apply
+		// cancels and restarts it.
+		if readErr := resourceGithubRepositorySourceImportRead(d, meta); readErr != nil {
+			log.Printf("[WARN] failed to read import into github repository %s/%s: %s", owner, repoName, readErr)
+		}
+		return err
+	}
+
+	return resourceGithubRepositorySourceImportRead(d, meta)
+}
+
+func resourceGithubRepositorySourceImportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+
+	log.Printf("[DEBUG] read import into github repository %s/%s", owner, repoName)
+	imp, resp, err := client.Migrations.ImportProgress(context.TODO(), owner, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing import into %s/%s from state because it no longer exists in github",
+				owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("repository", repoName)
+	d.Set("vcs_url", imp.GetVCSURL())
+	d.Set("vcs", imp.GetVCS())
+	d.Set("tfvc_project", imp.GetTFVCProject())
+	d.Set("status", imp.GetStatus())
+	d.Set("status_text", imp.GetStatusText())
+	d.Set("commit_count", imp.GetCommitCount())
+	d.Set("authors_count", imp.GetAuthorsCount())
+	d.Set("has_large_files", imp.GetHasLargeFiles())
+	d.Set("large_files_size", imp.GetLargeFilesSize())
+	d.Set("large_files_count", imp.GetLargeFilesCount())
+	d.Set("html_url", imp.GetHTMLURL())
+
+	return nil
+}
+
+func resourceGithubRepositorySourceImportDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+
+	imp, resp, err := client.Migrations.ImportProgress(context.TODO(), owner, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	// A completed import can't be undone, its commits stay in the repository.
+	if imp.GetStatus() == "complete" {
+		log.Printf("[DEBUG] import into github repository %s/%s is complete, removing it from state only", owner, repoName)
+		return nil
+	}
+
+	log.Printf("[DEBUG] cancel import into github repository %s/%s", owner, repoName)
+	_, err = client.Migrations.CancelImport(context.TODO(), owner, repoName)
+	return err
+}
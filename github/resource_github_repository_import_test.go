@@ -0,0 +1,90 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepositoryImport_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-import-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryImportConfig(repoName, "https://github.com/octocat/Hello-World.git"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryImportComplete("github_repository_import.test"),
+					resource.TestCheckResourceAttr("github_repository_import.test", "status", "complete"),
+					resource.TestCheckResourceAttr("github_repository_import.test", "vcs", "git"),
+					resource.TestCheckResourceAttr("github_repository_import.test", "has_large_files", "false"),
+					resource.TestCheckResourceAttrSet("github_repository_import.test", "authors_count"),
+				),
+			},
+			{
+				ResourceName:      "github_repository_import.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryImport_nothingFound(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-import-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGithubRepositoryImportConfig(repoName, "https://example.com/"),
+				ExpectError: regexp.MustCompile(`failed with status detection_found_nothing`),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryImportComplete(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		org := testAccProvider.Meta().(*Organization)
+		repo, _, err := org.client.Repositories.Get(context.TODO(), org.name, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if repo.GetSize() == 0 && repo.GetDefaultBranch() == "" {
+			return fmt.Errorf("repository %s is still empty after the import", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccGithubRepositoryImportConfig(repoName, vcsURL string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_import" "test" {
+  repository = "${github_repository.test.name}"
+  vcs        = "git"
+  vcs_url    = "%s"
+}
+`, repoName, vcsURL)
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -183,6 +185,42 @@ func TestAccGithubRepository_archiveUpdate(t *testing.T) {
 					}),
 				),
 			},
+			{
+				// Settings of an archived repository can still be changed.
+				Config: strings.Replace(testAccGithubRepositoryArchivedConfig(randString),
+					"has_wiki = true", "has_wiki = false", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					resource.TestCheckResourceAttr("github_repository.foo", "has_wiki", "false"),
+					resource.TestCheckResourceAttr("github_repository.foo", "archived", "true"),
+				),
+			},
+			{
+				// A failed change leaves the repository archived.
+				Config: strings.Replace(testAccGithubRepositoryArchivedConfig(randString),
+					"archived = true", "archived = true\n  default_branch = \"tf-acc-test-missing\"", 1),
+				ExpectError: regexp.MustCompile("default_branch"),
+			},
+			{
+				Config: strings.Replace(testAccGithubRepositoryArchivedConfig(randString),
+					"has_wiki = true", "has_wiki = false", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					func(s *terraform.State) error {
+						if !repo.GetArchived() {
+							return fmt.Errorf("Repository %s was left unarchived", repo.GetName())
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccGithubRepositoryConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					resource.TestCheckResourceAttr("github_repository.foo", "archived", "false"),
+				),
+			},
 		},
 	})
 }
@@ -302,6 +340,360 @@ func TestAccGithubRepository_templates(t *testing.T) {
 	})
 }
 
+func TestAccGithubRepository_fork(t *testing.T) {
+	var repo github.Repository
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-fork-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigFork(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					resource.TestCheckResourceAttr("github_repository.foo", "name", name),
+					resource.TestCheckResourceAttr("github_repository.foo", "description", "Terraform acceptance tests fork"),
+					resource.TestCheckResourceAttr("github_repository.foo", "fork", "true"),
+					resource.TestCheckResourceAttr("github_repository.foo", "parent_full_name", "octocat/Hello-World"),
+					resource.TestCheckResourceAttr("github_repository.foo", "source_full_name", "octocat/Hello-World"),
+				),
+			},
+			{
+				ResourceName:      "github_repository.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_forkExisting(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	existing := fmt.Sprintf("tf-acc-test-existing-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			org := testAccProvider.Meta().(*Organization)
+			if _, err := org.client.Repositories.Delete(context.TODO(), org.name, existing); err != nil {
+				return err
+			}
+			return testAccCheckGithubRepositoryDestroy(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					org := testAccProvider.Meta().(*Organization)
+					name, err := createGithubRepositoryFork(org.client, org.name, "octocat/Hello-World")
+					if err != nil {
+						t.Fatalf("Cannot fork repository: %s", err)
+					}
+					_, _, err = org.client.Repositories.Edit(context.TODO(), org.name, name, &github.Repository{
+						Name: github.String(existing),
+					})
+					if err != nil {
+						t.Fatalf("Cannot rename fork: %s", err)
+					}
+				},
+				Config:      testAccGithubRepositoryConfigFork(randString),
+				ExpectError: regexp.MustCompile(`already has a fork of octocat/Hello-World named ` + existing),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_forkNameTaken(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-fork-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			org := testAccProvider.Meta().(*Organization)
+			if _, err := org.client.Repositories.Delete(context.TODO(), org.name, "Hello-World"); err != nil {
+				return err
+			}
+			return testAccCheckGithubRepositoryDestroy(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				// An unrelated repository named after the source doesn't
+				// prevent forking it.
+				PreConfig: func() {
+					org := testAccProvider.Meta().(*Organization)
+					_, _, err := org.client.Repositories.Create(context.TODO(), org.name, &github.Repository{
+						Name: github.String("Hello-World"),
+					})
+					if err != nil {
+						t.Fatalf("Cannot create repository: %s", err)
+					}
+				},
+				Config: testAccGithubRepositoryConfigFork(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.foo", "name", name),
+					resource.TestCheckResourceAttr("github_repository.foo", "fork", "true"),
+					resource.TestCheckResourceAttr("github_repository.foo", "parent_full_name", "octocat/Hello-World"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_fromTemplate(t *testing.T) {
+	var repo github.Repository
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigFromTemplate(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.template", "is_template", "true"),
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+					resource.TestCheckResourceAttr("github_repository.foo", "name", fmt.Sprintf("tf-acc-test-%s", randString)),
+					resource.TestCheckResourceAttr("github_repository.foo", "description", "Terraform acceptance tests from template"),
+					resource.TestCheckResourceAttr("github_repository.foo", "has_issues", "true"),
+					resource.TestCheckResourceAttr("github_repository.foo", "is_template", "false"),
+				),
+			},
+			{
+				ResourceName:      "github_repository.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Imported forks and repositories generated from a template have fork_from
+// and template set, which must not replace them whether or not they are in
+// the configuration.
+func TestAccGithubRepository_originDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "foo",
+		Attributes: map[string]string{
+			"id":                    "foo",
+			"name":                  "foo",
+			"fork_from":             "octocat/Hello-World",
+			"template.#":            "1",
+			"template.0.owner":      "octocat",
+			"template.0.repository": "template",
+		},
+	}
+
+	cases := []map[string]interface{}{
+		{
+			"name": "foo",
+		},
+		{
+			"name":      "foo",
+			"fork_from": "octocat/hello-world",
+			"template": []interface{}{
+				map[string]interface{}{"owner": "octocat", "repository": "template"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		raw, err := config.NewRawConfig(tc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := resourceGithubRepository().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff.RequiresNew() {
+			t.Fatalf("Expected no replacement for %v, got diff: %#v", tc, diff)
+		}
+	}
+}
+
+func TestAccGithubRepository_rename(t *testing.T) {
+	var repo, renamed github.Repository
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigName(fmt.Sprintf("tf-acc-test-%s", randString)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &repo),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryConfigName(fmt.Sprintf("tf-acc-test-renamed-%s", randString)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists("github_repository.foo", &renamed),
+					resource.TestCheckResourceAttr("github_repository.foo", "id", fmt.Sprintf("tf-acc-test-renamed-%s", randString)),
+					resource.TestCheckResourceAttr("github_repository.foo", "name", fmt.Sprintf("tf-acc-test-renamed-%s", randString)),
+					func(s *terraform.State) error {
+						if repo.GetID() != renamed.GetID() {
+							return fmt.Errorf("got repository %d; want renamed repository %d", renamed.GetID(), repo.GetID())
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_renameWithDependents(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+	renamed := fmt.Sprintf("tf-acc-test-renamed-%s", randString)
+	var hookID, keyID, privateKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigWithDependents(name),
+				Check: func(s *terraform.State) error {
+					hook := s.RootModule().Resources["github_repository_webhook.foo"].Primary
+					key := s.RootModule().Resources["github_repository_deploy_key.foo"].Primary
+					hookID = hook.ID
+					_, keyID = parseTwoPartID(key.ID)
+					privateKey = key.Attributes["private_key"]
+					return nil
+				},
+			},
+			{
+				Config: testAccGithubRepositoryConfigWithDependents(renamed),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "repository", renamed),
+					resource.TestCheckResourceAttr("github_repository_deploy_key.foo", "repository", renamed),
+					func(s *terraform.State) error {
+						hook := s.RootModule().Resources["github_repository_webhook.foo"].Primary
+						key := s.RootModule().Resources["github_repository_deploy_key.foo"].Primary
+						if hook.ID != hookID {
+							return fmt.Errorf("got webhook %s; want webhook %s to follow the rename", hook.ID, hookID)
+						}
+						if want := buildTwoPartID(&renamed, &keyID); key.ID != want {
+							return fmt.Errorf("got deploy key %s; want deploy key %s to follow the rename", key.ID, want)
+						}
+						if key.Attributes["private_key"] != privateKey {
+							return fmt.Errorf("deploy key was generated again")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_moveDependents(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+	other := fmt.Sprintf("tf-acc-test-other-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigMoveDependents(randString, false),
+			},
+			{
+				// The other repository doesn't exist yet when planning, so
+				// the dependents are moved rather than replaced.
+				Config: testAccGithubRepositoryConfigMoveDependents(randString, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "repository", other),
+					resource.TestCheckResourceAttr("github_repository_deploy_key.foo", "repository", other),
+					resource.TestCheckResourceAttr("github_issue_label.foo", "repository", other),
+					func(s *terraform.State) error {
+						org := testAccProvider.Meta().(*Organization)
+						hooks, _, err := org.client.Repositories.ListHooks(context.TODO(), org.name, name, nil)
+						if err != nil {
+							return err
+						}
+						if len(hooks) != 0 {
+							return fmt.Errorf("got %d webhooks left in %s; want the webhook moved to %s", len(hooks), name, other)
+						}
+						keys, _, err := org.client.Repositories.ListKeys(context.TODO(), org.name, name, nil)
+						if err != nil {
+							return err
+						}
+						if len(keys) != 0 {
+							return fmt.Errorf("got %d deploy keys left in %s; want the deploy key moved to %s", len(keys), name, other)
+						}
+						_, resp, err := org.client.Issues.GetLabel(context.TODO(), org.name, name, "moved")
+						if err == nil || resp.StatusCode != 404 {
+							return fmt.Errorf("label is left in %s; want it moved to %s", name, other)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_deletionProtection(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigDeletionProtection(randString, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				// Removing the repository from the configuration destroys it.
+				Config:      testAccGithubRepositoryConfigEmpty,
+				ExpectError: regexp.MustCompile("deletion_protection is enabled"),
+			},
+			{
+				Config: testAccGithubRepositoryConfigDeletionProtection(randString, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_archiveOnDestroy(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryArchivedOnDestroy(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigArchiveOnDestroy(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.foo", "archive_on_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGithubRepository_topics(t *testing.T) {
 	var repo github.Repository
 	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -514,6 +906,26 @@ func testAccCheckGithubRepositoryAttributes(repo *github.Repository, want *testA
 	}
 }
 
+// testAccCheckGithubRepositoryArchivedOnDestroy checks that the repository
+// was archived rather than deleted, then deletes it.
+func testAccCheckGithubRepositoryArchivedOnDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*Organization).client
+		orgName := testAccProvider.Meta().(*Organization).name
+
+		repo, _, err := conn.Repositories.Get(context.TODO(), orgName, name)
+		if err != nil {
+			return err
+		}
+		if !repo.GetArchived() {
+			return fmt.Errorf("Repository %s/%s was not archived", orgName, name)
+		}
+
+		_, err = conn.Repositories.Delete(context.TODO(), orgName, name)
+		return err
+	}
+}
+
 func testAccCheckGithubRepositoryDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*Organization).client
 	orgName := testAccProvider.Meta().(*Organization).name
@@ -706,6 +1118,136 @@ resource "github_repository" "foo" {
 `, randString, randString)
 }
 
+func testAccGithubRepositoryConfigWithDependents(name string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "%s"
+}
+
+resource "github_repository_webhook" "foo" {
+  repository = "${github_repository.foo.name}"
+  name = "web"
+  events = ["push"]
+
+  configuration {
+    url = "https://example.com/webhook"
+  }
+}
+
+resource "github_repository_deploy_key" "foo" {
+  repository = "${github_repository.foo.name}"
+  title = "rename"
+  generate_key_type = "ed25519"
+}
+`, name)
+}
+
+func testAccGithubRepositoryConfigMoveDependents(randString string, move bool) string {
+	repository := "${github_repository.foo.name}"
+	other := ""
+	if move {
+		repository = "${github_repository.other.name}"
+		other = fmt.Sprintf(`
+resource "github_repository" "other" {
+  name = "tf-acc-test-other-%s"
+}
+`, randString)
+	}
+
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "tf-acc-test-%s"
+}
+%s
+resource "github_repository_webhook" "foo" {
+  repository = "%s"
+  name = "web"
+  events = ["push"]
+
+  configuration {
+    url = "https://example.com/webhook"
+  }
+}
+
+resource "github_repository_deploy_key" "foo" {
+  repository = "%s"
+  title = "move"
+  generate_key_type = "ed25519"
+}
+
+resource "github_issue_label" "foo" {
+  repository = "%s"
+  name = "moved"
+  color = "000000"
+}
+`, randString, other, repository, repository, repository)
+}
+
+func testAccGithubRepositoryConfigFork(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "tf-acc-test-fork-%s"
+  description = "Terraform acceptance tests fork"
+  fork_from = "octocat/Hello-World"
+}
+`, randString)
+}
+
+func testAccGithubRepositoryConfigFromTemplate(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "template" {
+  name = "tf-acc-test-template-%s"
+  description = "Terraform acceptance tests template"
+  auto_init = true
+  is_template = true
+}
+
+resource "github_repository" "foo" {
+  name = "tf-acc-test-%s"
+  description = "Terraform acceptance tests from template"
+  has_issues = true
+
+  template {
+    owner = "%s"
+    repository = "${github_repository.template.name}"
+  }
+}
+`, randString, randString, os.Getenv("GITHUB_ORGANIZATION"))
+}
+
+func testAccGithubRepositoryConfigName(name string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "%s"
+  description = "Terraform acceptance tests"
+}
+`, name)
+}
+
+const testAccGithubRepositoryConfigEmpty = `
+# No repositories
+`
+
+func testAccGithubRepositoryConfigDeletionProtection(randString string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "tf-acc-test-%s"
+  description = "Terraform acceptance tests"
+  deletion_protection = %t
+}
+`, randString, deletionProtection)
+}
+
+func testAccGithubRepositoryConfigArchiveOnDestroy(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "tf-acc-test-%s"
+  description = "Terraform acceptance tests"
+  archive_on_destroy = true
+}
+`, randString)
+}
+
 func testAccGithubRepositoryConfigTopics(randString string, topicList string) string {
 	return fmt.Sprintf(`
 resource "github_repository" "foo" {
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositoryTransfer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryTransferCreate,
+		Read:   resourceGithubRepositoryTransferRead,
+		// Transfers can't be undone or changed. Updating results in force new.
+		Delete: resourceGithubRepositoryTransferDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"new_owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateGithubID,
+				},
+				Set: schema.HashString,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryTransferCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	newOwner := d.Get("new_owner").(string)
+
+	teamIDs := []int64{}
+	for _, t := range d.Get("team_ids").(*schema.Set).List() {
+		teamIDs = append(teamIDs, toGithubID(t.(string)))
+	}
+
+	repo, err := transferGithubRepository(client, owner, repoName, &transferRepoRequest{
+		NewOwner: &newOwner,
+		TeamIDs:  teamIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&newOwner, repo.Name))
+
+	return resourceGithubRepositoryTransferRead(d, meta)
+}
+
+func resourceGithubRepositoryTransferRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	newOwner, repoName := parseTwoPartID(d.Id())
+
+	repo, resp, err := client.Repositories.Get(context.TODO(), newOwner, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing transfer of %s/%s from state because the repository no longer exists in github",
+				newOwner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("full_name", repo.FullName)
+	d.Set("html_url", repo.HTMLURL)
+
+	return nil
+}
+
+func resourceGithubRepositoryTransferDelete(d *schema.ResourceData, meta interface{}) error {
+	// The repository stays with its new owner, only the state is removed.
+	log.Printf("[DEBUG] removing transfer of %s from state, the repository is not transferred back", d.Id())
+	return nil
+}
@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepositoryTransfer_basic(t *testing.T) {
+	newOwner := os.Getenv("GITHUB_TEST_TRANSFER_OWNER")
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-transfer-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if newOwner == "" {
+				t.Skip("GITHUB_TEST_TRANSFER_OWNER must be set to an organization the repository can be transferred to")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryTransferDestroy(newOwner, repoName),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					org := testAccProvider.Meta().(*Organization)
+					_, _, err := org.client.Repositories.Create(context.TODO(), org.name, &github.Repository{
+						Name: github.String(repoName),
+					})
+					if err != nil {
+						t.Fatalf("Cannot create repository: %s", err)
+					}
+				},
+				Config: testAccGithubRepositoryTransferConfig(repoName, newOwner),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_transfer.test", "id", fmt.Sprintf("%s:%s", newOwner, repoName)),
+					resource.TestCheckResourceAttr("github_repository_transfer.test", "full_name", fmt.Sprintf("%s/%s", newOwner, repoName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryTransfer_managedRepository(t *testing.T) {
+	newOwner := os.Getenv("GITHUB_TEST_TRANSFER_OWNER")
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-transfer-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if newOwner == "" {
+				t.Skip("GITHUB_TEST_TRANSFER_OWNER must be set to an organization the repository can be transferred to")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryTransferDestroy(newOwner, repoName),
+		Steps: []resource.TestStep{
+			{
+				// The transferred repository keeps its state, so that plans
+				// stay empty.
+				Config: testAccGithubRepositoryTransferManagedConfig(repoName, newOwner, "before"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.test", "name", repoName),
+					resource.TestCheckResourceAttr("github_repository_transfer.test", "full_name", fmt.Sprintf("%s/%s", newOwner, repoName)),
+				),
+			},
+			{
+				Config:      testAccGithubRepositoryTransferManagedConfig(repoName, newOwner, "after"),
+				ExpectError: regexp.MustCompile("was transferred to " + newOwner),
+			},
+		},
+	})
+}
+
+// testAccCheckGithubRepositoryTransferDestroy checks that the repository
+// stayed with its new owner, then deletes it.
+func testAccCheckGithubRepositoryTransferDestroy(newOwner, repoName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*Organization).client
+
+		if _, _, err := conn.Repositories.Get(context.TODO(), newOwner, repoName); err != nil {
+			return err
+		}
+
+		_, err := conn.Repositories.Delete(context.TODO(), newOwner, repoName)
+		return err
+	}
+}
+
+func testAccGithubRepositoryTransferConfig(repoName, newOwner string) string {
+	return fmt.Sprintf(`
+resource "github_repository_transfer" "test" {
+  repository = "%s"
+  new_owner = "%s"
+}
+`, repoName, newOwner)
+}
+
+func testAccGithubRepositoryTransferManagedConfig(repoName, newOwner, description string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+  description = "%s"
+}
+
+resource "github_repository_transfer" "test" {
+  repository = "${github_repository.test.name}"
+  new_owner = "%s"
+}
+`, repoName, description, newOwner)
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 
@@ -16,6 +17,10 @@ func resourceGithubRepositoryWebhook() *schema.Resource {
 		Read:   resourceGithubRepositoryWebhookRead,
 		Update: resourceGithubRepositoryWebhookUpdate,
 		Delete: resourceGithubRepositoryWebhookDelete,
+
+		SchemaVersion: 1,
+		MigrateState:  resourceGithubWebhookMigrateState,
+		CustomizeDiff: resourceGithubRepositoryWebhookCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -37,22 +42,29 @@ func resourceGithubRepositoryWebhook() *schema.Resource {
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
-			},
-			"events": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
-			},
-			"configuration": {
-				Type:     schema.TypeMap,
-				Optional: true,
 			},
+			"events":        webhookEventsSchema(),
+			"configuration": webhookConfigurationSchema(),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"verify": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"ping", "test"}),
+			},
+			"fail_on_verify_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_response": webhookLastResponseSchema(),
 			"active": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -77,23 +89,87 @@ func resourceGithubRepositoryWebhookObject(d *schema.ResourceData) *github.Hook
 		URL:    &url,
 		Events: events,
 		Active: &active,
-		Config: d.Get("configuration").(map[string]interface{}),
+		Config: expandWebhookConfiguration(d),
 	}
 
 	return hook
 }
 
+func resourceGithubRepositoryWebhookCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceGithubWebhookCustomizeDiff(d, meta); err != nil {
+		return err
+	}
+	return resourceGithubRepositoryRenameCustomizeDiff(d, meta)
+}
+
 func resourceGithubRepositoryWebhookCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repo := d.Get("repository").(string)
 	hk := resourceGithubRepositoryWebhookObject(d)
 
-	hook, _, err := client.Repositories.CreateHook(context.TODO(), meta.(*Organization).name, d.Get("repository").(string), hk)
+	if err := checkGithubRepositoryWritable(client, owner, repo); err != nil {
+		return err
+	}
+
+	if url, ok := hk.Config["url"].(string); ok && d.Get("adopt_existing").(bool) {
+		existing, err := findGithubRepositoryWebhook(client, owner, repo, url)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			log.Printf("[DEBUG] Adopting existing repository webhook: %s/%s (%d)", owner, repo, existing.GetID())
+			_, _, err = client.Repositories.EditHook(context.TODO(), owner, repo, existing.GetID(), hk)
+			if err != nil {
+				return err
+			}
+			d.SetId(strconv.FormatInt(existing.GetID(), 10))
+
+			return resourceGithubRepositoryWebhookVerifyAndRead(d, meta)
+		}
+	}
+
+	hook, _, err := client.Repositories.CreateHook(context.TODO(), owner, repo, hk)
 	if err != nil {
 		return err
 	}
 	d.SetId(strconv.FormatInt(*hook.ID, 10))
 
-	return resourceGithubRepositoryWebhookRead(d, meta)
+	return resourceGithubRepositoryWebhookVerifyAndRead(d, meta)
+}
+
+// resourceGithubRepositoryWebhookVerifyAndRead pings the hook or triggers a
+// test push event when `verify` is set, then reads the hook including the
+// response of its receiver.
+func resourceGithubRepositoryWebhookVerifyAndRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repo := d.Get("repository").(string)
+	hookID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	var verifyErr error
+	if v, ok := d.GetOk("verify"); ok {
+		u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, hookID)
+		event := "ping"
+		if v.(string) == "test" {
+			event = "push"
+		}
+		verifyErr = verifyGithubWebhook(client, u, event, d.Get("fail_on_verify_error").(bool), func() (*github.Response, error) {
+			if v.(string) == "test" {
+				return client.Repositories.TestHook(context.TODO(), owner, repo, hookID)
+			}
+			return client.Repositories.PingHook(context.TODO(), owner, repo, hookID)
+		})
+	}
+
+	if err := resourceGithubRepositoryWebhookRead(d, meta); err != nil {
+		return err
+	}
+	return verifyErr
 }
 
 func resourceGithubRepositoryWebhookRead(d *schema.ResourceData, meta interface{}) error {
@@ -103,7 +179,7 @@ func resourceGithubRepositoryWebhookRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	hook, resp, err := client.Repositories.GetHook(context.TODO(), meta.(*Organization).name, d.Get("repository").(string), hookID)
+	hook, resp, err := getGithubWebhook(client, fmt.Sprintf("repos/%v/%v/hooks/%d", meta.(*Organization).name, d.Get("repository").(string), hookID))
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -115,7 +191,8 @@ func resourceGithubRepositoryWebhookRead(d *schema.ResourceData, meta interface{
 	d.Set("url", hook.URL)
 	d.Set("active", hook.Active)
 	d.Set("events", hook.Events)
-	d.Set("configuration", hook.Config)
+	d.Set("configuration", flattenWebhookConfiguration(d, hook.Config))
+	d.Set("last_response", flattenWebhookLastResponse(hook.LastResponse))
 
 	return nil
 }
@@ -128,12 +205,36 @@ func resourceGithubRepositoryWebhookUpdate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if d.HasChange("repository") {
+		o, n := d.GetChange("repository")
+		renamed, err := isGithubRepositoryRenamed(client, meta.(*Organization).name, o.(string), n.(string))
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return moveGithubRepositoryResource(d, meta, o.(string), func() error {
+				_, err := client.Repositories.DeleteHook(context.TODO(), meta.(*Organization).name, o.(string), hookID)
+				return err
+			}, resourceGithubRepositoryWebhookCreate)
+		}
+	}
+
+	if deferred, err := deferWriteToArchivedGithubRepository(client, meta.(*Organization).name, d.Get("repository").(string), "webhook "+d.Id()); err != nil || deferred {
+		if err != nil {
+			return err
+		}
+		// The secret isn't returned by GitHub, keep the one in use.
+		o, _ := d.GetChange("configuration")
+		d.Set("configuration", o)
+		return resourceGithubRepositoryWebhookRead(d, meta)
+	}
+
 	_, _, err = client.Repositories.EditHook(context.TODO(), meta.(*Organization).name, d.Get("repository").(string), hookID, hk)
 	if err != nil {
 		return err
 	}
 
-	return resourceGithubRepositoryWebhookRead(d, meta)
+	return resourceGithubRepositoryWebhookVerifyAndRead(d, meta)
 }
 
 func resourceGithubRepositoryWebhookDelete(d *schema.ResourceData, meta interface{}) error {
@@ -143,6 +244,10 @@ func resourceGithubRepositoryWebhookDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if skip, err := skipDeleteOfArchivedGithubRepository(client, meta.(*Organization).name, d.Get("repository").(string), "webhook "+d.Id()); err != nil || skip {
+		return err
+	}
+
 	_, err = client.Repositories.DeleteHook(context.TODO(), meta.(*Organization).name, d.Get("repository").(string), hookID)
 	return err
 }
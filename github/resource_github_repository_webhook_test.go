@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -50,6 +51,7 @@ func TestAccGithubRepositoryWebhook_basic(t *testing.T) {
 							"url":          "https://google.de/webhooks",
 							"content_type": "form",
 							"insecure_ssl": "0",
+							"secret":       "********",
 						},
 						Active: false,
 					}),
@@ -59,6 +61,132 @@ func TestAccGithubRepositoryWebhook_basic(t *testing.T) {
 	})
 }
 
+func TestAccGithubRepositoryWebhook_events(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGithubRepositoryWebhookEventsConfig(randString, `["pull_requests"]`),
+				ExpectError: regexp.MustCompile("pull_requests is an invalid value"),
+			},
+			{
+				Config:      testAccGithubRepositoryWebhookEventsConfig(randString, `["*", "push"]`),
+				ExpectError: regexp.MustCompile("can't be combined with other events"),
+			},
+			{
+				Config: testAccGithubRepositoryWebhookEventsConfig(randString, `["*"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "events.#", "1"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryWebhookEventsConfig(randString, `["push", "issues", "pull_request"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "events.#", "3"),
+				),
+			},
+			{
+				// The order of the events doesn't matter.
+				Config:   testAccGithubRepositoryWebhookEventsConfig(randString, `["pull_request", "push", "issues"]`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccGithubRepositoryWebhookEventsConfig(randString, `["check_run", "check_suite", "deploy_key", "star"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "events.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryWebhook_adoptExisting(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("foo-%s", randString)
+	var existing, hook github.Hook
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryWebhookRepositoryConfig(randString),
+			},
+			{
+				PreConfig: func() {
+					org := testAccProvider.Meta().(*Organization)
+					active := true
+					h, _, err := org.client.Repositories.CreateHook(context.TODO(), org.name, repoName, &github.Hook{
+						Name:   github.String("web"),
+						Events: []string{"push"},
+						Active: &active,
+						Config: map[string]interface{}{
+							"url":          "https://google.de/webhook",
+							"content_type": "form",
+						},
+					})
+					if err != nil {
+						t.Fatalf("Cannot create webhook: %s", err)
+					}
+					existing = *h
+				},
+				Config: testAccGithubRepositoryWebhookAdoptConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryWebhookExists("github_repository_webhook.foo", repoName, &hook),
+					func(s *terraform.State) error {
+						if hook.GetID() != existing.GetID() {
+							return fmt.Errorf("got hook %d; want adopted hook %d", hook.GetID(), existing.GetID())
+						}
+						return nil
+					},
+					testAccCheckGithubRepositoryWebhookAttributes(&hook, &testAccGithubRepositoryWebhookExpectedAttributes{
+						Name:   "web",
+						Events: []string{"pull_request"},
+						Configuration: map[string]interface{}{
+							"url":          "https://google.de/webhook",
+							"content_type": "json",
+							"insecure_ssl": "0",
+						},
+						Active: true,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRepositoryWebhook_verify(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryWebhookVerifyConfig(randString, "https://httpbin.org/status/204", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "last_response.#", "1"),
+					resource.TestCheckResourceAttr("github_repository_webhook.foo", "last_response.0.code", "204"),
+				),
+			},
+			{
+				Config:      testAccGithubRepositoryWebhookVerifyConfig(randString, "https://httpbin.org/status/500", true),
+				ExpectError: regexp.MustCompile("webhook receiver responded with 500"),
+			},
+			{
+				Config:      testAccGithubRepositoryWebhookVerifyTestConfig(randString, `["issues"]`),
+				ExpectError: regexp.MustCompile("isn't subscribed to push events"),
+			},
+		},
+	})
+}
+
 func TestAccGithubRepositoryWebhook_importBasic(t *testing.T) {
 	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
@@ -71,10 +199,11 @@ func TestAccGithubRepositoryWebhook_importBasic(t *testing.T) {
 				Config: testAccGithubRepositoryWebhookConfig(randString),
 			},
 			{
-				ResourceName:        "github_repository_webhook.foo",
-				ImportState:         true,
-				ImportStateVerify:   true,
-				ImportStateIdPrefix: fmt.Sprintf("foo-%s/", randString),
+				ResourceName:            "github_repository_webhook.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("foo-%s/", randString),
+				ImportStateVerifyIgnore: []string{"adopt_existing", "fail_on_verify_error"},
 			},
 		},
 	})
@@ -218,6 +347,7 @@ resource "github_repository_webhook" "foo" {
     url = "https://google.de/webhooks"
     content_type = "form"
     insecure_ssl = false
+    secret = "secret"
   }
   active = false
 
@@ -225,3 +355,86 @@ resource "github_repository_webhook" "foo" {
 }
 `, randString, randString)
 }
+
+func testAccGithubRepositoryWebhookEventsConfig(randString, events string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "foo-%s"
+  description = "Terraform acceptance tests"
+}
+
+resource "github_repository_webhook" "foo" {
+  repository = "${github_repository.foo.name}"
+
+  name = "web"
+  configuration {
+    url = "https://google.de/webhook"
+    content_type = "json"
+  }
+
+  events = %s
+}
+`, randString, events)
+}
+
+func testAccGithubRepositoryWebhookRepositoryConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name = "foo-%s"
+  description = "Terraform acceptance tests"
+}
+`, randString)
+}
+
+func testAccGithubRepositoryWebhookAdoptConfig(randString string) string {
+	return testAccGithubRepositoryWebhookRepositoryConfig(randString) + `
+resource "github_repository_webhook" "foo" {
+  repository = "${github_repository.foo.name}"
+
+  name = "web"
+  configuration {
+    url = "https://google.de/webhook"
+    content_type = "json"
+  }
+  adopt_existing = true
+
+  events = ["pull_request"]
+}
+`
+}
+
+func testAccGithubRepositoryWebhookVerifyConfig(randString, url string, failOnVerifyError bool) string {
+	return testAccGithubRepositoryWebhookRepositoryConfig(randString) + fmt.Sprintf(`
+resource "github_repository_webhook" "foo" {
+  repository = "${github_repository.foo.name}"
+
+  name = "web"
+  configuration {
+    url = "%s"
+    content_type = "json"
+  }
+  verify = "ping"
+  fail_on_verify_error = %t
+
+  events = ["push"]
+}
+`, url, failOnVerifyError)
+}
+
+func testAccGithubRepositoryWebhookVerifyTestConfig(randString, events string) string {
+	return testAccGithubRepositoryWebhookRepositoryConfig(randString) + fmt.Sprintf(`
+resource "github_repository_webhook" "foo" {
+  repository = "${github_repository.foo.name}"
+
+  name = "web"
+  configuration {
+    url = "https://httpbin.org/status/204"
+    content_type = "json"
+  }
+  verify = "test"
+  fail_on_verify_error = true
+
+  events = %s
+}
+`, events)
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/go-github/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -34,8 +35,14 @@ func resourceGithubTeam() *schema.Resource {
 				ValidateFunc: validateValueFunc([]string{"secret", "closed"}),
 			},
 			"parent_team_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"parent_team_slug"},
+			},
+			"parent_team_slug": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent_team_id"},
 			},
 			"ldap_dn": {
 				Type:     schema.TypeString,
@@ -56,10 +63,11 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 		Description: &desc,
 		Privacy:     &p,
 	}
-	if parentTeamID, ok := d.GetOk("parent_team_id"); ok {
-		id := int64(parentTeamID.(int))
-		newTeam.ParentTeamID = &id
+	parentTeamID, err := getGithubTeamParentID(d, meta)
+	if err != nil {
+		return err
 	}
+	newTeam.ParentTeamID = parentTeamID
 
 	githubTeam, _, err := client.Organizations.CreateTeam(context.TODO(), meta.(*Organization).name, newTeam)
 	if err != nil {
@@ -92,9 +100,16 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", team.Name)
 	d.Set("privacy", team.Privacy)
 	if parent := team.Parent; parent != nil {
-		d.Set("parent_team_id", parent.GetID())
+		// Only one of the parent attributes may be configured, so only fill
+		// in the one in use to avoid a diff on the other.
+		if _, ok := d.GetOk("parent_team_slug"); ok {
+			d.Set("parent_team_slug", parent.GetSlug())
+		} else {
+			d.Set("parent_team_id", parent.GetID())
+		}
 	} else {
 		d.Set("parent_team_id", "")
+		d.Set("parent_team_slug", "")
 	}
 	d.Set("ldap_dn", team.GetLDAPDN())
 	return nil
@@ -118,12 +133,12 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 		Description: &description,
 		Privacy:     &privacy,
 	}
-	if parentTeamID, ok := d.GetOk("parent_team_id"); ok {
-		id := int64(parentTeamID.(int))
-		editedTeam.ParentTeamID = &id
+	parentTeamID, err := getGithubTeamParentID(d, meta)
+	if err != nil {
+		return err
 	}
 
-	team, _, err = client.Organizations.EditTeam(context.TODO(), *team.ID, editedTeam)
+	team, _, err = editGithubTeam(client, *team.ID, editedTeam, parentTeamID)
 	if err != nil {
 		return err
 	}
@@ -155,3 +170,49 @@ func getGithubTeam(d *schema.ResourceData, github *github.Client) (*github.Team,
 	team, _, err := github.Organizations.GetTeam(context.TODO(), id)
 	return team, err
 }
+
+// getGithubTeamParentID returns the ID of the configured parent team, looking
+// it up by slug if needed, or nil if the team has no parent.
+func getGithubTeamParentID(d *schema.ResourceData, meta interface{}) (*int64, error) {
+	if parentTeamID, ok := d.GetOk("parent_team_id"); ok {
+		id := int64(parentTeamID.(int))
+		return &id, nil
+	}
+
+	if parentTeamSlug, ok := d.GetOk("parent_team_slug"); ok {
+		parent, err := getGithubTeamBySlug(meta.(*Organization).client, meta.(*Organization).name, parentTeamSlug.(string))
+		if err != nil {
+			return nil, err
+		}
+		return parent.ID, nil
+	}
+
+	return nil, nil
+}
+
+// editTeamRequest always sends the parent team ID, as null when the team has
+// no parent. github.NewTeam omits a nil ParentTeamID, which leaves a nested
+// team attached to its current parent.
+type editTeamRequest struct {
+	*github.NewTeam
+	ParentTeamID *int64 `json:"parent_team_id"`
+}
+
+func editGithubTeam(client *github.Client, id int64, team *github.NewTeam, parentTeamID *int64) (*github.Team, *github.Response, error) {
+	body := &editTeamRequest{NewTeam: team, ParentTeamID: parentTeamID}
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("teams/%v", id), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when the nested teams API fully launches.
+	req.Header.Set("Accept", "application/vnd.github.hellcat-preview+json")
+
+	t := new(github.Team)
+	resp, err := client.Do(context.TODO(), req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubTeamDiscussion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubTeamDiscussionCreate,
+		Read:   resourceGithubTeamDiscussionRead,
+		Update: resourceGithubTeamDiscussionUpdate,
+		Delete: resourceGithubTeamDiscussionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), ":")
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("Invalid ID specified. Supplied ID must be written as <team_id>:<discussion_number>")
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"pinned": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubTeamDiscussionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t := d.Get("team_id").(string)
+
+	discussion := github.TeamDiscussion{
+		Title:   github.String(d.Get("title").(string)),
+		Body:    github.String(d.Get("body").(string)),
+		Private: github.Bool(d.Get("private").(bool)),
+		Pinned:  github.Bool(d.Get("pinned").(bool)),
+	}
+
+	log.Printf("[DEBUG] Creating team discussion: %s (%s)", t, discussion.GetTitle())
+	result, _, err := client.Teams.CreateDiscussion(context.TODO(), toGithubID(t), discussion)
+	if err != nil {
+		return err
+	}
+
+	n := strconv.FormatInt(result.GetNumber(), 10)
+	d.SetId(buildTwoPartID(&t, &n))
+
+	return resourceGithubTeamDiscussionRead(d, meta)
+}
+
+func resourceGithubTeamDiscussionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t, n := parseTwoPartID(d.Id())
+
+	number, err := strconv.Atoi(n)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading team discussion: %s (%d)", t, number)
+	discussion, resp, err := client.Teams.GetDiscussion(context.TODO(), toGithubID(t), number)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing team discussion %s from state because it no longer exists in github", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("team_id", t)
+	d.Set("title", discussion.GetTitle())
+	d.Set("body", discussion.GetBody())
+	d.Set("private", discussion.GetPrivate())
+	d.Set("pinned", discussion.GetPinned())
+	d.Set("number", discussion.GetNumber())
+	d.Set("html_url", discussion.GetHTMLURL())
+
+	return nil
+}
+
+func resourceGithubTeamDiscussionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t, n := parseTwoPartID(d.Id())
+
+	number, err := strconv.Atoi(n)
+	if err != nil {
+		return err
+	}
+
+	discussion := github.TeamDiscussion{
+		Title:  github.String(d.Get("title").(string)),
+		Body:   github.String(d.Get("body").(string)),
+		Pinned: github.Bool(d.Get("pinned").(bool)),
+	}
+
+	log.Printf("[DEBUG] Updating team discussion: %s (%d)", t, number)
+	_, _, err = client.Teams.EditDiscussion(context.TODO(), toGithubID(t), number, discussion)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubTeamDiscussionRead(d, meta)
+}
+
+func resourceGithubTeamDiscussionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t, n := parseTwoPartID(d.Id())
+
+	number, err := strconv.Atoi(n)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting team discussion: %s (%d)", t, number)
+	_, err = client.Teams.DeleteDiscussion(context.TODO(), toGithubID(t), number)
+	return err
+}
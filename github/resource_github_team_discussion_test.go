@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubTeamDiscussion_basic(t *testing.T) {
+	var discussion github.TeamDiscussion
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamDiscussionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamDiscussionConfig(randString, "Terraform acc test discussion"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamDiscussionExists("github_team_discussion.test", &discussion),
+					testAccCheckGithubTeamDiscussionBody(&discussion, "Terraform acc test discussion"),
+				),
+			},
+			{
+				Config: testAccGithubTeamDiscussionConfig(randString, "Terraform acc test discussion - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamDiscussionExists("github_team_discussion.test", &discussion),
+					testAccCheckGithubTeamDiscussionBody(&discussion, "Terraform acc test discussion - updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubTeamDiscussion_importBasic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamDiscussionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamDiscussionConfig(randString, "Terraform acc test discussion"),
+			},
+			{
+				ResourceName:      "github_team_discussion.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubTeamDiscussionExists(n string, discussion *github.TeamDiscussion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No team discussion ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		t, num := parseTwoPartID(rs.Primary.ID)
+		number, err := strconv.Atoi(num)
+		if err != nil {
+			return err
+		}
+
+		teamDiscussion, _, err := conn.Teams.GetDiscussion(context.TODO(), toGithubID(t), number)
+		if err != nil {
+			return err
+		}
+		*discussion = *teamDiscussion
+		return nil
+	}
+}
+
+func testAccCheckGithubTeamDiscussionBody(discussion *github.TeamDiscussion, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if discussion.GetBody() != body {
+			return fmt.Errorf("Team discussion body does not match: %s, %s", discussion.GetBody(), body)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGithubTeamDiscussionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_team_discussion" {
+			continue
+		}
+
+		t, num := parseTwoPartID(rs.Primary.ID)
+		number, err := strconv.Atoi(num)
+		if err != nil {
+			return err
+		}
+
+		discussion, resp, err := conn.Teams.GetDiscussion(context.TODO(), toGithubID(t), number)
+		if err == nil {
+			if discussion != nil {
+				return fmt.Errorf("Team discussion still exists")
+			}
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccGithubTeamDiscussionConfig(randString, body string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+	name = "tf-acc-test-discussion-%s"
+	description = "Terraform acc test group"
+}
+
+resource "github_team_discussion" "test" {
+	team_id = "${github_team.test.id}"
+	title = "tf-acc-test-discussion-%s"
+	body = "%s"
+}
+`, randString, randString, body)
+}
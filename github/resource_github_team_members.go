@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubTeamMembers() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceGithubTeamMembersCreate,
+		Read:   resourceGithubTeamMembersRead,
+		Update: resourceGithubTeamMembersUpdate,
+		Delete: resourceGithubTeamMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "member",
+							ValidateFunc: validateValueFunc([]string{"member", "maintainer"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t := d.Get("team_id").(string)
+
+	for _, m := range d.Get("members").(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		n := member["username"].(string)
+		r := member["role"].(string)
+
+		log.Printf("[DEBUG] Adding team member: %s (%s: %s)", t, n, r)
+		_, _, err := client.Organizations.AddTeamMembership(context.TODO(), toGithubID(t), n,
+			&github.OrganizationAddTeamMembershipOptions{Role: r})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(t)
+
+	return resourceGithubTeamMembersRead(d, meta)
+}
+
+func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t := d.Id()
+
+	if _, _, err := client.Organizations.GetTeam(context.TODO(), toGithubID(t)); err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	roster := make(map[string]string)
+	for _, role := range []string{"maintainer", "member"} {
+		users, err := listGithubTeamMembers(client, toGithubID(t), role)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range users {
+			roster[u.GetLogin()] = role
+		}
+	}
+
+	// Users who are not yet part of the organization are invited to the team
+	// and don't show up in the member listing until they accept, so keep the
+	// configured entries whose membership is still pending.
+	for _, m := range d.Get("members").(*schema.Set).List() {
+		n := m.(map[string]interface{})["username"].(string)
+		if _, ok := roster[n]; ok {
+			continue
+		}
+
+		membership, _, err := client.Organizations.GetTeamMembership(context.TODO(), toGithubID(t), n)
+		if err == nil && membership.GetState() == "pending" {
+			roster[n] = membership.GetRole()
+		}
+	}
+
+	members := make([]interface{}, 0, len(roster))
+	for n, r := range roster {
+		members = append(members, map[string]interface{}{
+			"username": n,
+			"role":     r,
+		})
+	}
+
+	d.Set("team_id", t)
+	d.Set("members", members)
+	return nil
+}
+
+func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t := d.Get("team_id").(string)
+
+	o, n := d.GetChange("members")
+	desired := make(map[string]string)
+	for _, m := range n.(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		desired[member["username"].(string)] = member["role"].(string)
+	}
+
+	// The previous state holds the full roster read back from GitHub, so any
+	// user missing from the configuration is removed from the team here.
+	for _, m := range o.(*schema.Set).List() {
+		u := m.(map[string]interface{})["username"].(string)
+		if _, ok := desired[u]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing team member: %s (%s)", t, u)
+		_, err := client.Organizations.RemoveTeamMembership(context.TODO(), toGithubID(t), u)
+		if err != nil {
+			return err
+		}
+	}
+
+	// AddTeamMembership adds new members and updates the role of existing ones.
+	for _, m := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+		member := m.(map[string]interface{})
+		u := member["username"].(string)
+		r := member["role"].(string)
+
+		log.Printf("[DEBUG] Adding team member: %s (%s: %s)", t, u, r)
+		_, _, err := client.Organizations.AddTeamMembership(context.TODO(), toGithubID(t), u,
+			&github.OrganizationAddTeamMembershipOptions{Role: r})
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubTeamMembersRead(d, meta)
+}
+
+func resourceGithubTeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	t := d.Get("team_id").(string)
+
+	for _, m := range d.Get("members").(*schema.Set).List() {
+		u := m.(map[string]interface{})["username"].(string)
+
+		log.Printf("[DEBUG] Removing team member: %s (%s)", t, u)
+		_, err := client.Organizations.RemoveTeamMembership(context.TODO(), toGithubID(t), u)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubTeamMembers_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamMembersConfig(randString, testCollaborator, "member"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamMembersRoleState("github_team_members.test_team_members", testCollaborator, "member"),
+					resource.TestCheckResourceAttr("github_team_members.test_team_members", "members.#", "2"),
+				),
+			},
+			{
+				Config: testAccGithubTeamMembersConfig(randString, testCollaborator, "maintainer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamMembersRoleState("github_team_members.test_team_members", testCollaborator, "maintainer"),
+					resource.TestCheckResourceAttr("github_team_members.test_team_members", "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubTeamMembers_importBasic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamMembersConfig(randString, testCollaborator, "member"),
+			},
+			{
+				ResourceName:      "github_team_members.test_team_members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubTeamMembersDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_team_members" {
+			continue
+		}
+
+		members, resp, err := conn.Organizations.ListTeamMembers(context.TODO(), toGithubID(rs.Primary.ID), nil)
+		if err == nil {
+			if len(members) > 0 {
+				return fmt.Errorf("Team still has %d members", len(members))
+			}
+			continue
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckGithubTeamMembersRoleState(n, username, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No team ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		membership, _, err := conn.Organizations.GetTeamMembership(context.TODO(), toGithubID(rs.Primary.ID), username)
+		if err != nil {
+			return err
+		}
+
+		if membership.GetRole() != expected {
+			return fmt.Errorf("Team membership role %v does not match expected state of %v", membership.GetRole(), expected)
+		}
+		return nil
+	}
+}
+
+func testAccGithubTeamMembersConfig(randString, username, role string) string {
+	return fmt.Sprintf(`
+  resource "github_membership" "test_org_membership" {
+    username = "%s"
+    role = "member"
+  }
+
+  resource "github_team" "test_team" {
+    name = "tf-acc-test-team-members-%s"
+    description = "Terraform acc test group"
+  }
+
+  resource "github_team_members" "test_team_members" {
+    team_id = "${github_team.test_team.id}"
+
+    # GitHub makes the creator of a team one of its maintainers.
+    members {
+      username = "%s"
+      role = "maintainer"
+    }
+
+    members {
+      username = "${github_membership.test_org_membership.username}"
+      role = "%s"
+    }
+  }
+`, username, randString, testUser, role)
+}
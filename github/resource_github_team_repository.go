@@ -16,6 +16,7 @@ func resourceGithubTeamRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryRenameCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"team_id": {
@@ -26,13 +27,12 @@ func resourceGithubTeamRepository() *schema.Resource {
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"permission": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "pull",
-				ValidateFunc: validateValueFunc([]string{"pull", "push", "admin"}),
+				ValidateFunc: validateValueFunc([]string{"pull", "triage", "push", "maintain", "admin"}),
 			},
 		},
 	}
@@ -89,6 +89,20 @@ func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta interface{}
 	r := d.Get("repository").(string)
 	p := d.Get("permission").(string)
 
+	if d.HasChange("repository") {
+		o, _ := d.GetChange("repository")
+		renamed, err := isGithubRepositoryRenamed(client, meta.(*Organization).name, o.(string), r)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return moveGithubRepositoryResource(d, meta, o.(string), func() error {
+				_, err := client.Organizations.RemoveTeamRepo(context.TODO(), toGithubID(t), meta.(*Organization).name, o.(string))
+				return err
+			}, resourceGithubTeamRepositoryCreate)
+		}
+	}
+
 	// the go-github library's AddTeamRepo method uses the add/update endpoint from Github API
 	_, err := client.Organizations.AddTeamRepo(context.TODO(), toGithubID(t), meta.(*Organization).name, r,
 		&github.OrganizationAddTeamRepoOptions{Permission: p})
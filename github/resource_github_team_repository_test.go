@@ -65,6 +65,8 @@ func TestAccCheckGetPermissions(t *testing.T) {
 	pullMap := map[string]bool{"pull": true, "push": false, "admin": false}
 	pushMap := map[string]bool{"pull": true, "push": true, "admin": false}
 	adminMap := map[string]bool{"pull": true, "push": true, "admin": true}
+	triageMap := map[string]bool{"pull": true, "triage": true, "push": false, "maintain": false, "admin": false}
+	maintainMap := map[string]bool{"pull": true, "triage": true, "push": true, "maintain": true, "admin": false}
 	errorMap := map[string]bool{"pull": false, "push": false, "admin": false}
 
 	pull, _ := getRepoPermission(&pullMap)
@@ -82,12 +84,49 @@ func TestAccCheckGetPermissions(t *testing.T) {
 		t.Fatalf("Expected admin permission, actual: %s", admin)
 	}
 
+	triage, _ := getRepoPermission(&triageMap)
+	if triage != "triage" {
+		t.Fatalf("Expected triage permission, actual: %s", triage)
+	}
+
+	maintain, _ := getRepoPermission(&maintainMap)
+	if maintain != "maintain" {
+		t.Fatalf("Expected maintain permission, actual: %s", maintain)
+	}
+
 	errPerm, err := getRepoPermission(&errorMap)
 	if err == nil {
 		t.Fatalf("Expected an error getting permissions, actual: %v", errPerm)
 	}
 }
 
+func TestAccCheckGetTeamPermission(t *testing.T) {
+	maintainMap := map[string]bool{"pull": true, "triage": true, "push": true, "maintain": true, "admin": false}
+
+	triage, _ := getTeamPermission(&repoTeam{
+		Team:     &github.Team{Permission: github.String("pull")},
+		RoleName: github.String("triage"),
+	})
+	if triage != "triage" {
+		t.Fatalf("Expected triage permission, actual: %s", triage)
+	}
+
+	maintain, _ := getTeamPermission(&repoTeam{
+		Team:        &github.Team{Permission: github.String("push")},
+		Permissions: &maintainMap,
+	})
+	if maintain != "maintain" {
+		t.Fatalf("Expected maintain permission, actual: %s", maintain)
+	}
+
+	push, _ := getTeamPermission(&repoTeam{
+		Team: &github.Team{Permission: github.String("push")},
+	})
+	if push != "push" {
+		t.Fatalf("Expected push permission, actual: %s", push)
+	}
+}
+
 func testAccCheckGithubTeamRepositoryRoleState(role string, repository *github.Repository) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceRole, err := getRepoPermission(repository.Permissions)
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-github/github"
@@ -60,6 +61,21 @@ func TestAccGithubTeam_hierarchical(t *testing.T) {
 					testAccCheckGithubTeamAttributes(&child, childName, "Terraform acc test child team", &parent),
 				),
 			},
+			{
+				Config: testAccGithubTeamHierarchicalSlugConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamExists("github_team.parent", &parent),
+					testAccCheckGithubTeamExists("github_team.child", &child),
+					testAccCheckGithubTeamAttributes(&child, childName, "Terraform acc test child team", &parent),
+				),
+			},
+			{
+				Config: testAccGithubTeamHierarchicalDetachedConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamExists("github_team.child", &child),
+					testAccCheckGithubTeamAttributes(&child, childName, "Terraform acc test child team", nil),
+				),
+			},
 		},
 	})
 }
@@ -185,3 +201,35 @@ resource "github_team" "child" {
 }
 `, randString, randString)
 }
+
+func testAccGithubTeamHierarchicalSlugConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "parent" {
+	name = "tf-acc-parent-%s"
+	description = "Terraform acc test parent team"
+	privacy = "closed"
+}
+resource "github_team" "child" {
+	name = "tf-acc-child-%s"
+	description = "Terraform acc test child team"
+	privacy = "closed"
+	parent_team_slug = "tf-acc-parent-%s"
+	depends_on = ["github_team.parent"]
+}
+`, randString, randString, strings.ToLower(randString))
+}
+
+func testAccGithubTeamHierarchicalDetachedConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "parent" {
+	name = "tf-acc-parent-%s"
+	description = "Terraform acc test parent team"
+	privacy = "closed"
+}
+resource "github_team" "child" {
+	name = "tf-acc-child-%s"
+	description = "Terraform acc test child team"
+	privacy = "closed"
+}
+`, randString, randString)
+}
@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubUserGpgKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubUserGpgKeyCreate,
+		Read:   resourceGithubUserGpgKeyRead,
+		// GPG keys are defined immutable in the API. Updating results in force new.
+		Delete: resourceGithubUserGpgKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"armored_public_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// GitHub doesn't return the armored public key, so an imported
+				// key has none in state until it is replaced.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGithubUserGpgKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	k := d.Get("armored_public_key").(string)
+
+	log.Printf("[DEBUG] Creating user GPG key")
+	key, _, err := client.Users.CreateGPGKey(context.TODO(), k)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*key.ID, 10))
+
+	return resourceGithubUserGpgKeyRead(d, meta)
+}
+
+func resourceGithubUserGpgKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading user GPG key: %s", d.Id())
+	key, resp, err := client.Users.GetGPGKey(context.TODO(), id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing user GPG key %s from state because it no longer exists in github", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	emails := []string{}
+	for _, e := range key.Emails {
+		emails = append(emails, e.GetEmail())
+	}
+
+	// GitHub doesn't return the armored public key, so it is kept as configured.
+	d.Set("key_id", key.GetKeyID())
+	d.Set("emails", emails)
+
+	return nil
+}
+
+func resourceGithubUserGpgKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting user GPG key: %s", d.Id())
+	_, err = client.Users.DeleteGPGKey(context.TODO(), id)
+	return err
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func TestAccGithubUserGpgKey_basic(t *testing.T) {
+	publicKey, err := testAccGithubUserGpgKeyGenerate()
+	if err != nil {
+		t.Fatalf("Cannot generate GPG key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubUserGpgKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubUserGpgKeyConfig(publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubUserGpgKeyExists("github_user_gpg_key.test"),
+					resource.TestCheckResourceAttrSet("github_user_gpg_key.test", "key_id"),
+					resource.TestCheckResourceAttr("github_user_gpg_key.test", "emails.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "github_user_gpg_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// GitHub doesn't return the armored public key
+				ImportStateVerifyIgnore: []string{"armored_public_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckGithubUserGpgKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_user_gpg_key" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := conn.Users.GetGPGKey(context.TODO(), id)
+		if err == nil {
+			return fmt.Errorf("User GPG key %s still exists", rs.Primary.ID)
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckGithubUserGpgKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user GPG key ID is set")
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		_, _, err = conn.Users.GetGPGKey(context.TODO(), id)
+		return err
+	}
+}
+
+func testAccGithubUserGpgKeyGenerate() (string, error) {
+	entity, err := openpgp.NewEntity("Terraform Acc Test", "", "tf-acc-test@example.com", nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Serializing the private key computes the self-signatures which
+	// Serialize expects to be present.
+	if err := entity.SerializePrivate(ioutil.Discard, nil); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := entity.Serialize(w); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func testAccGithubUserGpgKeyConfig(publicKey string) string {
+	return fmt.Sprintf(`
+resource "github_user_gpg_key" "test" {
+	armored_public_key = "%s"
+}
+`, strings.Replace(publicKey, "\n", "\\n", -1))
+}
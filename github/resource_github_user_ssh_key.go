@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubUserSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubUserSshKeyCreate,
+		Read:   resourceGithubUserSshKeyRead,
+		// SSH keys are defined immutable in the API. Updating results in force new.
+		Delete: resourceGithubUserSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentSSHPublicKeys,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubUserSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	t := d.Get("title").(string)
+	k := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Creating user SSH key: %s", t)
+	key, _, err := client.Users.CreateKey(context.TODO(), &github.Key{
+		Title: &t,
+		Key:   &k,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*key.ID, 10))
+
+	return resourceGithubUserSshKeyRead(d, meta)
+}
+
+func resourceGithubUserSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading user SSH key: %s", d.Id())
+	key, resp, err := client.Users.GetKey(context.TODO(), id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] removing user SSH key %s from state because it no longer exists in github", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	fingerprint, err := sshPublicKeyFingerprint(key.GetKey())
+	if err != nil {
+		return err
+	}
+
+	d.Set("title", key.GetTitle())
+	d.Set("key", key.GetKey())
+	d.Set("fingerprint", fingerprint)
+	d.Set("url", key.GetURL())
+
+	return nil
+}
+
+func resourceGithubUserSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting user SSH key: %s", d.Id())
+	_, err = client.Users.DeleteKey(context.TODO(), id)
+	return err
+}
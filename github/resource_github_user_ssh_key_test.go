@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubUserSshKey_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	publicKey, _, err := acctest.RandSSHKeyPair("tf-acc-test@example.com")
+	if err != nil {
+		t.Fatalf("Cannot generate SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubUserSshKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubUserSshKeyConfig(randString, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubUserSshKeyExists("github_user_ssh_key.test"),
+					resource.TestCheckResourceAttr("github_user_ssh_key.test", "title", fmt.Sprintf("tf-acc-test-%s", randString)),
+					resource.TestMatchResourceAttr("github_user_ssh_key.test", "fingerprint", regexp.MustCompile(`^SHA256:`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubUserSshKey_importBasic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	publicKey, _, err := acctest.RandSSHKeyPair("tf-acc-test@example.com")
+	if err != nil {
+		t.Fatalf("Cannot generate SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubUserSshKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubUserSshKeyConfig(randString, publicKey),
+			},
+			{
+				ResourceName:      "github_user_ssh_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// GitHub doesn't return the comment of the key
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
+
+func testAccCheckGithubUserSshKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_user_ssh_key" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := conn.Users.GetKey(context.TODO(), id)
+		if err == nil {
+			return fmt.Errorf("User SSH key %s still exists", rs.Primary.ID)
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckGithubUserSshKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user SSH key ID is set")
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		_, _, err = conn.Users.GetKey(context.TODO(), id)
+		return err
+	}
+}
+
+func testAccGithubUserSshKeyConfig(randString, publicKey string) string {
+	return fmt.Sprintf(`
+resource "github_user_ssh_key" "test" {
+	title = "tf-acc-test-%s"
+	key = "%s"
+}
+`, randString, publicKey)
+}
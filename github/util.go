@@ -23,6 +23,15 @@ func fromGithubID(id *int64) string {
 	return strconv.FormatInt(*id, 10)
 }
 
+// validateGithubID checks that a value is a numeric ID, as toGithubID turns
+// anything else into 0.
+func validateGithubID(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := strconv.ParseInt(v.(string), 10, 64); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a numeric ID, got %q", k, v.(string)))
+	}
+	return
+}
+
 func validateValueFunc(values []string) schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (we []string, errors []error) {
 		value := v.(string)
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+// organizationSettings extends github.Organization with the settings that
+// the vendored go-github doesn't know about yet.
+type organizationSettings struct {
+	*github.Organization
+
+	DefaultRepositoryPermission  *string `json:"default_repository_permission,omitempty"`
+	MembersCanCreateRepositories *bool   `json:"members_can_create_repositories,omitempty"`
+}
+
+func getOrganizationSettings(client *github.Client, org string) (*organizationSettings, *github.Response, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%v", org), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(organizationSettings)
+	resp, err := client.Do(context.TODO(), req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// editOrganizationSettings is the equivalent of Organizations.Edit, accepting
+// the additional settings of organizationSettings.
+func editOrganizationSettings(client *github.Client, org string, settings *organizationSettings) (*organizationSettings, *github.Response, error) {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%v", org), settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edited := new(organizationSettings)
+	resp, err := client.Do(context.TODO(), req, edited)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return edited, resp, nil
+}
@@ -1,49 +1,177 @@
 package github
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/google/go-github/github"
+	"github.com/google/go-querystring/query"
 )
 
 const (
-	pullPermission  string = "pull"
-	pushPermission  string = "push"
-	adminPermission string = "admin"
+	pullPermission     string = "pull"
+	triagePermission   string = "triage"
+	pushPermission     string = "push"
+	maintainPermission string = "maintain"
+	adminPermission    string = "admin"
 
 	writePermission string = "write"
 	readPermission  string = "read"
 )
 
+// repoPermissions lists the repository permission levels in increasing order.
+var repoPermissions = []string{
+	pullPermission,
+	triagePermission,
+	pushPermission,
+	maintainPermission,
+	adminPermission,
+}
+
 func getRepoPermission(p *map[string]bool) (string, error) {
 
 	// Permissions are returned in this map format such that if you have a certain level
 	// of permission, all levels below are also true. For example, if a team has push
-	// permission, the map will be: {"pull": true, "push": true, "admin": false}
-	if (*p)[adminPermission] {
-		return adminPermission, nil
-	} else if (*p)[pushPermission] {
-		return pushPermission, nil
-	} else {
-		if (*p)[pullPermission] {
-			return pullPermission, nil
+	// permission, the map will be: {"pull": true, "triage": true, "push": true,
+	// "maintain": false, "admin": false}. The "triage" and "maintain" keys are
+	// missing from older API versions.
+	for i := len(repoPermissions) - 1; i >= 0; i-- {
+		if (*p)[repoPermissions[i]] {
+			return repoPermissions[i], nil
 		}
-		return "", errors.New("At least one permission expected from permissions map.")
 	}
+	return "", errors.New("At least one permission expected from permissions map.")
 }
 
 func getInvitationPermission(i *github.RepositoryInvitation) (string, error) {
-	// Permissions for some GitHub API routes are expressed as "read",
-	// "write", and "admin"; in other places, they are expressed as "pull",
-	// "push", and "admin".
-	if *i.Permissions == readPermission {
+	return getRoleNamePermission(*i.Permissions)
+}
+
+func getRoleNamePermission(roleName string) (string, error) {
+	// Permissions for some GitHub API routes are expressed as "read", "triage",
+	// "write", "maintain" and "admin"; in other places, they are expressed as
+	// "pull", "triage", "push", "maintain" and "admin".
+	switch roleName {
+	case readPermission:
 		return pullPermission, nil
-	} else if *i.Permissions == writePermission {
+	case writePermission:
 		return pushPermission, nil
-	} else if *i.Permissions == adminPermission {
-		return adminPermission, nil
+	case triagePermission, maintainPermission, adminPermission:
+		return roleName, nil
+	}
+
+	return "", fmt.Errorf("unexpected permission value: %v", roleName)
+}
+
+func getInvitationPermissionName(permission string) (string, error) {
+	// Invitations are updated using the "read" and "write" names, so map the
+	// "pull" and "push" names used everywhere else onto them.
+	switch permission {
+	case pullPermission:
+		return readPermission, nil
+	case pushPermission:
+		return writePermission, nil
+	case triagePermission, maintainPermission, adminPermission:
+		return permission, nil
+	}
+
+	return "", fmt.Errorf("unexpected permission value: %v", permission)
+}
+
+// repoCollaborator is a github.User as returned when listing the collaborators
+// of a repository, along with the name of the collaborator's role, which the
+// vendored go-github doesn't know about yet.
+type repoCollaborator struct {
+	*github.User
+
+	RoleName *string `json:"role_name,omitempty"`
+}
+
+// getCollaboratorPermission returns the permission of a collaborator, preferring
+// the role name over the permissions map when the API returns it.
+func getCollaboratorPermission(c *repoCollaborator) (string, error) {
+	if c.RoleName != nil {
+		return getRoleNamePermission(*c.RoleName)
+	}
+	return getRepoPermission(c.Permissions)
+}
+
+// listRepoCollaborators is the equivalent of Repositories.ListCollaborators,
+// returning the role name of each collaborator.
+func listRepoCollaborators(client *github.Client, owner string, repo string, opt *github.ListCollaboratorsOptions) ([]*repoCollaborator, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/collaborators", owner, repo)
+	if opt != nil {
+		qs, err := query.Values(opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		u = fmt.Sprintf("%s?%s", u, qs.Encode())
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// TODO: remove custom Accept header when the nested teams API fully launches.
+	req.Header.Set("Accept", "application/vnd.github.hellcat-preview+json")
+
+	var collaborators []*repoCollaborator
+	resp, err := client.Do(context.TODO(), req, &collaborators)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return collaborators, resp, nil
+}
+
+// repoTeam is a github.Team as returned when listing the teams of a
+// repository, along with the team's permissions on the repository, which the
+// vendored go-github doesn't know about yet.
+type repoTeam struct {
+	*github.Team
+
+	RoleName    *string          `json:"role_name,omitempty"`
+	Permissions *map[string]bool `json:"permissions,omitempty"`
+}
+
+// getTeamPermission returns the permission of a team on a repository. The
+// legacy permission field only knows about pull, push and admin, so it is the
+// last resort.
+func getTeamPermission(t *repoTeam) (string, error) {
+	if t.RoleName != nil {
+		return getRoleNamePermission(*t.RoleName)
+	}
+	if t.Permissions != nil {
+		return getRepoPermission(t.Permissions)
+	}
+	return t.GetPermission(), nil
+}
+
+// listRepoTeams is the equivalent of Repositories.ListTeams, returning the
+// role name and permissions of each team.
+func listRepoTeams(client *github.Client, owner string, repo string, opt *github.ListOptions) ([]*repoTeam, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/teams", owner, repo)
+	if opt != nil {
+		qs, err := query.Values(opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		u = fmt.Sprintf("%s?%s", u, qs.Encode())
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// TODO: remove custom Accept header when the nested teams API fully launches.
+	req.Header.Set("Accept", "application/vnd.github.hellcat-preview+json")
+
+	var teams []*repoTeam
+	resp, err := client.Do(context.TODO(), req, &teams)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return "", fmt.Errorf("unexpected permission value: %v", *i.Permissions)
+	return teams, resp, nil
 }
@@ -0,0 +1,480 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// repositoryTemplatePreview is the media type of the repository template
+// API preview.
+const repositoryTemplatePreview = "application/vnd.github.baptiste-preview+json"
+
+// repository extends github.Repository with the fields the vendored
+// go-github doesn't know about yet.
+type repository struct {
+	*github.Repository
+
+	IsTemplate         *bool              `json:"is_template,omitempty"`
+	TemplateRepository *github.Repository `json:"template_repository,omitempty"`
+}
+
+// getGithubRepository is the equivalent of Repositories.Get, returning the
+// additional fields of repository.
+func getGithubRepository(client *github.Client, owner, name string) (*repository, *github.Response, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%v/%v", owner, name), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// TODO: remove custom Accept header when the topics and template APIs fully launch.
+	req.Header.Set("Accept", strings.Join([]string{"application/vnd.github.mercy-preview+json", repositoryTemplatePreview}, ", "))
+
+	repo := new(repository)
+	resp, err := client.Do(context.TODO(), req, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repo, resp, nil
+}
+
+// editGithubRepository is the equivalent of Repositories.Edit, accepting the
+// additional fields of repository.
+func editGithubRepository(client *github.Client, owner, name string, repo *repository) (*repository, *github.Response, error) {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("repos/%v/%v", owner, name), repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	// TODO: remove custom Accept header when the template API fully launches.
+	req.Header.Set("Accept", repositoryTemplatePreview)
+
+	edited := new(repository)
+	resp, err := client.Do(context.TODO(), req, edited)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return edited, resp, nil
+}
+
+// templateRepoRequest is the body of a request to create a repository from
+// a template repository.
+type templateRepoRequest struct {
+	Owner       *string `json:"owner,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Private     *bool   `json:"private,omitempty"`
+}
+
+// createGithubRepositoryFromTemplate creates a repository from the template
+// repository templateOwner/templateRepo.
+func createGithubRepositoryFromTemplate(client *github.Client, templateOwner, templateRepo string, templateReq *templateRepoRequest) (*github.Repository, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/generate", templateOwner, templateRepo)
+	req, err := client.NewRequest("POST", u, templateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	// TODO: remove custom Accept header when the template API fully launches.
+	req.Header.Set("Accept", repositoryTemplatePreview)
+
+	repo := new(github.Repository)
+	resp, err := client.Do(context.TODO(), req, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repo, resp, nil
+}
+
+// suppressRepositoryOriginDiff suppresses the diff of fork_from and template
+// when they are left out of the configuration, as a repository can't stop
+// being a fork or generated from a template, and between names differing
+// only in case.
+func suppressRepositoryOriginDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".#") {
+		return new == "0" || new == ""
+	}
+	return new == "" || strings.EqualFold(old, new)
+}
+
+// repositoryForkTimeout is how long to wait for GitHub to finish creating a
+// fork in the background.
+const repositoryForkTimeout = 5 * time.Minute
+
+// validateRepositoryFullName checks that a value is of the form owner/repo.
+func validateRepositoryFullName(v interface{}, k string) (ws []string, errors []error) {
+	parts := strings.Split(v.(string), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		errors = append(errors, fmt.Errorf("%s must be of the form owner/repository, got %q", k, v.(string)))
+	}
+	return
+}
+
+// createGithubRepositoryFork forks the repository fullName into the
+// organization and waits until the fork is available, returning its name.
+func createGithubRepositoryFork(client *github.Client, org, fullName string) (string, error) {
+	parts := strings.SplitN(fullName, "/", 2)
+	owner, repo := parts[0], parts[1]
+
+	// When the organization already has a fork of the source, GitHub answers
+	// with that fork instead of creating a new one, which must not be taken
+	// over.
+	existing, err := findGithubRepositoryFork(client, owner, repo, org)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", fmt.Errorf("%s already has a fork of %s named %s, import it instead of forking again", org, fullName, existing.GetName())
+	}
+
+	log.Printf("[DEBUG] fork github repository %s into %s", fullName, org)
+	fork, _, err := client.Repositories.CreateFork(context.TODO(), owner, repo,
+		&github.RepositoryCreateForkOptions{Organization: org})
+	if err != nil {
+		// GitHub creates the fork in the background and answers with 202.
+		if _, ok := err.(*github.AcceptedError); !ok {
+			return "", err
+		}
+	}
+
+	// The fork is named after the source, or gets a suffix when the
+	// organization already has a repository of that name.
+	name := repo
+	if fork != nil && fork.Name != nil {
+		name = *fork.Name
+	}
+
+	err = resource.Retry(repositoryForkTimeout, func() *resource.RetryError {
+		r, resp, err := client.Repositories.Get(context.TODO(), org, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				return resource.RetryableError(fmt.Errorf("fork %s/%s is not available yet", org, name))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if !r.GetFork() || !strings.EqualFold(r.GetParent().GetFullName(), fullName) {
+			return resource.NonRetryableError(fmt.Errorf("%s/%s is not a fork of %s", org, name, fullName))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// findGithubRepositoryFork returns the fork of owner/repo owned by org, or
+// nil if there is none.
+func findGithubRepositoryFork(client *github.Client, owner, repo, org string) (*github.Repository, error) {
+	opt := &github.RepositoryListForksOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for {
+		forks, resp, err := client.Repositories.ListForks(context.TODO(), owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range forks {
+			if strings.EqualFold(f.GetOwner().GetLogin(), org) {
+				return f, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// isGithubRepositoryArchived reports whether a repository is archived, and
+// thus read-only. A missing repository is reported as not archived, leaving
+// the error to the request that follows.
+func isGithubRepositoryArchived(client *github.Client, owner, name string) (bool, error) {
+	repo, resp, err := client.Repositories.Get(context.TODO(), owner, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	return repo.GetArchived(), nil
+}
+
+// setGithubRepositoryArchived archives or unarchives a repository.
+func setGithubRepositoryArchived(client *github.Client, owner, name string, archived bool) error {
+	_, _, err := client.Repositories.Edit(context.TODO(), owner, name, &github.Repository{
+		Name:     &name,
+		Archived: &archived,
+	})
+	return err
+}
+
+// checkGithubRepositoryWritable fails with a clear error instead of the
+// rejection of GitHub when a repository is archived.
+func checkGithubRepositoryWritable(client *github.Client, owner, name string) error {
+	archived, err := isGithubRepositoryArchived(client, owner, name)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return fmt.Errorf("Repository %s/%s is archived and therefore read-only. Unarchive it before changing its settings.", owner, name)
+	}
+	return nil
+}
+
+// deferWriteToArchivedGithubRepository reports whether a change to a part of
+// a repository should be deferred because the repository is archived, as
+// GitHub rejects it. The part then keeps its current state, so that the change
+// is planned again until the repository is unarchived.
+func deferWriteToArchivedGithubRepository(client *github.Client, owner, name, what string) (bool, error) {
+	archived, err := isGithubRepositoryArchived(client, owner, name)
+	if err != nil {
+		return false, err
+	}
+	if archived {
+		log.Printf("[WARN] Repository %s/%s is archived, deferring changes to %s until it is unarchived", owner, name, what)
+	}
+	return archived, nil
+}
+
+// skipDeleteOfArchivedGithubRepository reports whether deleting a part of a
+// repository should be skipped because the repository is archived. The part
+// is then only removed from the state, as GitHub rejects the deletion.
+func skipDeleteOfArchivedGithubRepository(client *github.Client, owner, name, what string) (bool, error) {
+	archived, err := isGithubRepositoryArchived(client, owner, name)
+	if err != nil {
+		return false, err
+	}
+	if archived {
+		log.Printf("[WARN] Repository %s/%s is archived, removing %s from state without deleting it", owner, name, what)
+	}
+	return archived, nil
+}
+
+// withGithubRepositoryUnarchived calls f with the repository unarchived,
+// archiving it again afterwards if it was archived. It is used for the
+// changes to access to a repository, which can't wait for the repository to
+// be unarchived.
+func withGithubRepositoryUnarchived(client *github.Client, owner, name string, f func() error) error {
+	archived, err := isGithubRepositoryArchived(client, owner, name)
+	if err != nil {
+		return err
+	}
+	if !archived {
+		return f()
+	}
+
+	log.Printf("[WARN] Repository %s/%s is archived, unarchiving it to change access to it", owner, name)
+	if err := setGithubRepositoryArchived(client, owner, name, false); err != nil {
+		return err
+	}
+
+	fErr := f()
+	if err := setGithubRepositoryArchived(client, owner, name, true); err != nil {
+		if fErr != nil {
+			log.Printf("[WARN] failed to archive github repository %s/%s again: %s", owner, name, err)
+			return fErr
+		}
+		return err
+	}
+	return fErr
+}
+
+// githubRepositoryTransferredTo returns the owner a repository was
+// transferred to, GitHub redirecting requests for a transferred repository to
+// its new owner, or an empty string if it wasn't transferred.
+func githubRepositoryTransferredTo(client *github.Client, owner, name string) (string, error) {
+	repo, resp, err := client.Repositories.Get(context.TODO(), owner, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if newOwner := repo.GetOwner().GetLogin(); !strings.EqualFold(newOwner, owner) {
+		return newOwner, nil
+	}
+	return "", nil
+}
+
+// repositoryTransferTimeout is how long to wait for GitHub to finish
+// transferring a repository in the background.
+const repositoryTransferTimeout = 5 * time.Minute
+
+// transferRepoRequest is the body of a request to transfer a repository.
+type transferRepoRequest struct {
+	NewOwner *string `json:"new_owner,omitempty"`
+	TeamIDs  []int64 `json:"team_ids,omitempty"`
+}
+
+// transferGithubRepository transfers the repository owner/name to a new owner
+// and waits until it is available there.
+func transferGithubRepository(client *github.Client, owner, name string, transferReq *transferRepoRequest) (*github.Repository, error) {
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%v/%v/transfer", owner, name), transferReq)
+	if err != nil {
+		return nil, err
+	}
+	// TODO: remove custom Accept header when the repository transfer API fully launches.
+	req.Header.Set("Accept", "application/vnd.github.nightshade-preview+json")
+
+	log.Printf("[DEBUG] transfer github repository %s/%s to %s", owner, name, *transferReq.NewOwner)
+	_, err = client.Do(context.TODO(), req, nil)
+	if err != nil {
+		// GitHub transfers the repository in the background and answers with 202.
+		if _, ok := err.(*github.AcceptedError); !ok {
+			return nil, err
+		}
+	}
+
+	var repo *github.Repository
+	err = resource.Retry(repositoryTransferTimeout, func() *resource.RetryError {
+		r, resp, err := client.Repositories.Get(context.TODO(), *transferReq.NewOwner, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				return resource.RetryableError(fmt.Errorf("repository %s/%s is not available yet", *transferReq.NewOwner, name))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		// Requests for the old location are redirected once the transfer is
+		// done, so only the owner tells whether the repository moved.
+		if !strings.EqualFold(r.GetOwner().GetLogin(), *transferReq.NewOwner) {
+			return resource.RetryableError(fmt.Errorf("repository %s/%s has not been transferred yet", owner, name))
+		}
+		repo = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// repositoryImportFailedStatuses are the source import statuses that need
+// changes to the import before it can continue.
+var repositoryImportFailedStatuses = []string{
+	"auth_failed",
+	"error",
+	"detection_needs_auth",
+	"detection_found_nothing",
+	"detection_found_multiple",
+}
+
+// waitForGithubRepositoryImport polls the source import of a repository until
+// it completes, fails or the timeout expires.
+func waitForGithubRepositoryImport(client *github.Client, owner, name string, timeout time.Duration) (*github.Import, error) {
+	var imp *github.Import
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		i, _, err := client.Migrations.ImportProgress(context.TODO(), owner, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		imp = i
+
+		status := i.GetStatus()
+		log.Printf("[DEBUG] import into github repository %s/%s is %s: %s", owner, name, status, i.GetStatusText())
+		if status == "complete" {
+			return nil
+		}
+		for _, failed := range repositoryImportFailedStatuses {
+			if status == failed {
+				return resource.NonRetryableError(fmt.Errorf("import into %s/%s failed with status %s at step %q: %s",
+					owner, name, status, i.GetFailedStep(), i.GetMessage()))
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("import into %s/%s is still %s", owner, name, status))
+	})
+	return imp, err
+}
+
+// resourceGithubRepositoryRenameCustomizeDiff replaces a resource of a
+// repository when its repository argument changes to another existing
+// repository. When the repository it changes to doesn't exist yet, it is the
+// new name of the repository, which is renamed in place, so the resource is
+// updated to follow it instead, or a repository created in the same apply,
+// which moveGithubRepositoryResource takes care of.
+func resourceGithubRepositoryRenameCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("repository") || !d.NewValueKnown("repository") {
+		return nil
+	}
+
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	o, n := d.GetChange("repository")
+
+	oldRepo, resp, err := client.Repositories.Get(context.TODO(), owner, o.(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return d.ForceNew("repository")
+		}
+		return err
+	}
+	newRepo, resp, err := client.Repositories.Get(context.TODO(), owner, n.(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	if oldRepo.GetID() != newRepo.GetID() {
+		return d.ForceNew("repository")
+	}
+	return nil
+}
+
+// isGithubRepositoryRenamed reports whether the repository oldName was
+// renamed to newName, GitHub redirecting requests for the previous name of a
+// renamed repository. A missing oldName is reported as not renamed.
+func isGithubRepositoryRenamed(client *github.Client, owner, oldName, newName string) (bool, error) {
+	oldRepo, resp, err := client.Repositories.Get(context.TODO(), owner, oldName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	newRepo, _, err := client.Repositories.Get(context.TODO(), owner, newName)
+	if err != nil {
+		return false, err
+	}
+
+	if oldRepo.GetID() != newRepo.GetID() {
+		return false, nil
+	}
+	log.Printf("[DEBUG] github repository %s/%s was renamed to %s", owner, oldName, newName)
+	return true, nil
+}
+
+// moveGithubRepositoryResource moves a resource to the repository it now
+// belongs to when that repository is a different one than the repository
+// oldName, rather than its new name. This happens when the other repository
+// is created in the same apply, so it couldn't be told apart from a rename
+// when planning. The resource is removed from oldName with del, which may
+// fail with a 404 if it is already gone, and created again with create.
+func moveGithubRepositoryResource(d *schema.ResourceData, meta interface{}, oldName string, del func() error, create schema.CreateFunc) error {
+	log.Printf("[DEBUG] Moving %s from repository %s to %s", d.Id(), oldName, d.Get("repository").(string))
+	if err := del(); err != nil {
+		if err, ok := err.(*github.ErrorResponse); !ok || err.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+	d.SetId("")
+
+	return create(d, meta)
+}
@@ -0,0 +1,219 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+// normalizeSSHPublicKey returns the key material of an OpenSSH public key in
+// the `<type> <base64>` format GitHub returns keys in, dropping any comment and
+// surrounding whitespace.
+func normalizeSSHPublicKey(key string) (string, error) {
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pk))), nil
+}
+
+// sshPublicKeyFingerprint returns the SHA256 fingerprint of an OpenSSH public
+// key, as displayed by `ssh-keygen -l`.
+func sshPublicKeyFingerprint(key string) (string, error) {
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(pk), nil
+}
+
+// suppressEquivalentSSHPublicKeys suppresses diffs between two OpenSSH public
+// keys that only differ in their comment or whitespace.
+func suppressEquivalentSSHPublicKeys(k, old, new string, d *schema.ResourceData) bool {
+	oldKey, err := normalizeSSHPublicKey(old)
+	if err != nil {
+		return false
+	}
+	newKey, err := normalizeSSHPublicKey(new)
+	if err != nil {
+		return false
+	}
+	return oldKey == newKey
+}
+
+// supportedSSHPublicKeyTypes lists the OpenSSH public key types GitHub accepts.
+var supportedSSHPublicKeyTypes = []string{
+	ssh.KeyAlgoRSA,
+	ssh.KeyAlgoDSA,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoED25519,
+}
+
+// validateSSHPublicKey checks at plan time that a value is an OpenSSH public
+// key of a type GitHub supports.
+func validateSSHPublicKey(v interface{}, k string) (ws []string, errors []error) {
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(v.(string)))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s must be an OpenSSH public key: %s", k, err))
+		return
+	}
+
+	for _, t := range supportedSSHPublicKeyTypes {
+		if pk.Type() == t {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%s has unsupported key type %s, must be one of %s",
+		k, pk.Type(), strings.Join(supportedSSHPublicKeyTypes, ", ")))
+	return
+}
+
+// deployKey extends github.Key with the fields of a deploy key that the
+// vendored go-github doesn't know about yet.
+type deployKey struct {
+	*github.Key
+
+	CreatedAt *github.Timestamp `json:"created_at,omitempty"`
+	Verified  *bool             `json:"verified,omitempty"`
+}
+
+// getDeployKey is the equivalent of Repositories.GetKey, returning the
+// additional fields of deployKey.
+func getDeployKey(client *github.Client, owner, repo string, id int64) (*deployKey, *github.Response, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(deployKey)
+	resp, err := client.Do(context.TODO(), req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, nil
+}
+
+// defaultRSABits is the size of a generated RSA key when rsa_bits is unset.
+const defaultRSABits = 4096
+
+// suppressDefaultRSABits suppresses the diff of rsa_bits when no RSA key is
+// generated, and between an unset value and defaultRSABits, so that keys
+// created before rsa_bits existed aren't replaced.
+func suppressDefaultRSABits(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("generate_key_type").(string) != "rsa" {
+		return true
+	}
+
+	normalize := func(v string) string {
+		if v == "" || v == "0" {
+			return strconv.Itoa(defaultRSABits)
+		}
+		return v
+	}
+	return normalize(old) == normalize(new)
+}
+
+// generateSSHKeyPair generates a new key pair of the given type, either
+// "ed25519" or "rsa", returning the OpenSSH public key and the PEM encoded
+// private key. bits is only used for RSA keys.
+func generateSSHKeyPair(keyType string, bits int) (string, string, error) {
+	var publicKey interface{}
+	var block *pem.Block
+
+	switch keyType {
+	case "ed25519":
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+		b, err := marshalOpenSSHEd25519PrivateKey(pub, priv)
+		if err != nil {
+			return "", "", err
+		}
+		publicKey = pub
+		block = &pem.Block{
+			Type:  "OPENSSH PRIVATE KEY",
+			Bytes: b,
+		}
+	case "rsa":
+		priv, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return "", "", err
+		}
+		publicKey = &priv.PublicKey
+		block = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(priv),
+		}
+	default:
+		return "", "", fmt.Errorf("unsupported key type %s", keyType)
+	}
+
+	pk, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pk))), string(pem.EncodeToMemory(block)), nil
+}
+
+// marshalOpenSSHEd25519PrivateKey encodes an unencrypted ed25519 private key
+// in the openssh-key-v1 format, the only format OpenSSH reads ed25519 keys
+// from. See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.key
+func marshalOpenSSHEd25519PrivateKey(pub ed25519.PublicKey, priv ed25519.PrivateKey) ([]byte, error) {
+	pubKey := ssh.Marshal(struct {
+		Keytype string
+		Pub     []byte
+	}{ssh.KeyAlgoED25519, pub})
+
+	check := make([]byte, 4)
+	if _, err := rand.Read(check); err != nil {
+		return nil, err
+	}
+
+	privKeyBlock := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		Keytype string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+	}{
+		Check1:  binary.BigEndian.Uint32(check),
+		Check2:  binary.BigEndian.Uint32(check),
+		Keytype: ssh.KeyAlgoED25519,
+		Pub:     pub,
+		Priv:    priv,
+	})
+	// The private section is padded to the cipher block size of 8 with the
+	// bytes 1, 2, 3, ...
+	for i := 1; len(privKeyBlock)%8 != 0; i++ {
+		privKeyBlock = append(privKeyBlock, byte(i))
+	}
+
+	key := ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{"none", "none", "", 1, pubKey, privKeyBlock})
+
+	return append(append([]byte("openssh-key-v1"), 0), key...), nil
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const testSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ6vqxYpCbhRhdKDFYWDYyI9C0yrYZVqNbfHtHdpN8Qz"
+
+func TestAccGithubUtilNormalizeSSHPublicKey(t *testing.T) {
+	cases := []string{
+		testSSHPublicKey,
+		testSSHPublicKey + " someone@example.com",
+		"  " + testSSHPublicKey + "\n",
+	}
+
+	for _, tc := range cases {
+		key, err := normalizeSSHPublicKey(tc)
+		if err != nil {
+			t.Fatalf("Expected no error normalizing %q, actual: %s", tc, err)
+		}
+		if key != testSSHPublicKey {
+			t.Fatalf("Expected normalized key %q, actual: %q", testSSHPublicKey, key)
+		}
+	}
+
+	if _, err := normalizeSSHPublicKey("ssh-ed25519 invalid"); err == nil {
+		t.Fatalf("Expected an error normalizing an invalid key")
+	}
+}
+
+func TestAccGithubUtilSuppressEquivalentSSHPublicKeys(t *testing.T) {
+	if !suppressEquivalentSSHPublicKeys("key", testSSHPublicKey, testSSHPublicKey+" someone@example.com", nil) {
+		t.Fatalf("Expected keys differing in their comment to be equivalent")
+	}
+
+	if suppressEquivalentSSHPublicKeys("key", testSSHPublicKey, "ssh-ed25519 invalid", nil) {
+		t.Fatalf("Expected an invalid key not to be equivalent")
+	}
+}
+
+func TestAccGithubUtilSSHPublicKeyFingerprint(t *testing.T) {
+	fingerprint, err := sshPublicKeyFingerprint(testSSHPublicKey)
+	if err != nil {
+		t.Fatalf("Expected no error getting fingerprint, actual: %s", err)
+	}
+	if len(fingerprint) == 0 || fingerprint[:7] != "SHA256:" {
+		t.Fatalf("Expected a SHA256 fingerprint, actual: %s", fingerprint)
+	}
+}
+
+func TestAccGithubUtilValidateSSHPublicKey(t *testing.T) {
+	cases := []struct {
+		key         string
+		expectError bool
+	}{
+		{testSSHPublicKey, false},
+		{testSSHPublicKey + " someone@example.com", false},
+		{"ssh-ed25519 invalid", true},
+		{"not a key", true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSSHPublicKey(tc.key, "key")
+		if tc.expectError && len(errors) == 0 {
+			t.Fatalf("Expected an error validating %q", tc.key)
+		}
+		if !tc.expectError && len(errors) > 0 {
+			t.Fatalf("Expected no error validating %q, actual: %v", tc.key, errors)
+		}
+	}
+}
+
+func TestAccGithubUtilGenerateSSHKeyPair(t *testing.T) {
+	for _, keyType := range []string{"ed25519", "rsa"} {
+		publicKey, privateKey, err := generateSSHKeyPair(keyType, 2048)
+		if err != nil {
+			t.Fatalf("Expected no error generating %s key pair, actual: %s", keyType, err)
+		}
+
+		if _, errors := validateSSHPublicKey(publicKey, "key"); len(errors) > 0 {
+			t.Fatalf("Expected a valid %s public key, actual: %v", keyType, errors)
+		}
+
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		if err != nil {
+			t.Fatalf("Expected a parseable %s private key, actual: %s", keyType, err)
+		}
+
+		actual := string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+		if expected, _ := normalizeSSHPublicKey(publicKey); expected+"\n" != actual {
+			t.Fatalf("Expected private key to match public key %q, actual: %q", expected, actual)
+		}
+	}
+
+	if _, _, err := generateSSHKeyPair("dsa", 1024); err == nil {
+		t.Fatalf("Expected an error generating an unsupported key type")
+	}
+}
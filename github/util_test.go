@@ -34,6 +34,26 @@ func TestAccGithubUtilRole_validation(t *testing.T) {
 	}
 }
 
+func TestAccGithubUtilGithubID_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{"1234567", 0},
+		{"", 1},
+		{"my-team", 1},
+		{"12ab", 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateGithubID(tc.Value, "test_arg")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestAccGithubUtilTwoPartID(t *testing.T) {
 	partOne, partTwo := "foo", "bar"
 
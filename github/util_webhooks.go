@@ -0,0 +1,614 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/google/go-querystring/query"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// maskedWebhookSecret is what GitHub returns in place of a webhook's secret.
+const maskedWebhookSecret = "********"
+
+// webhookEvents lists the events a repository or organization webhook can
+// subscribe to, following the webhook events documentation of GitHub. The
+// events only delivered to GitHub Apps, such as installation and
+// marketplace_purchase, and the ping event are left out. Events GitHub adds
+// must be added here before they can be used.
+var webhookEvents = []string{
+	"branch_protection_rule",
+	"check_run",
+	"check_suite",
+	"code_scanning_alert",
+	"commit_comment",
+	"create",
+	"delete",
+	"dependabot_alert",
+	"deploy_key",
+	"deployment",
+	"deployment_status",
+	"discussion",
+	"discussion_comment",
+	"fork",
+	"gollum",
+	"issue_comment",
+	"issues",
+	"label",
+	"member",
+	"membership",
+	"merge_group",
+	"meta",
+	"milestone",
+	"org_block",
+	"organization",
+	"package",
+	"page_build",
+	"project",
+	"project_card",
+	"project_column",
+	"public",
+	"pull_request",
+	"pull_request_review",
+	"pull_request_review_comment",
+	"pull_request_review_thread",
+	"push",
+	"registry_package",
+	"release",
+	"repository",
+	"repository_import",
+	"repository_ruleset",
+	"repository_vulnerability_alert",
+	"secret_scanning_alert",
+	"secret_scanning_alert_location",
+	"security_and_analysis",
+	"star",
+	"status",
+	"team",
+	"team_add",
+	"watch",
+	"workflow_dispatch",
+	"workflow_job",
+	"workflow_run",
+}
+
+// webhookEventsSchema is the `events` set shared by the repository and
+// organization webhook resources. Being a set, the order GitHub returns the
+// events in never causes a diff.
+func webhookEventsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateValueFunc(append([]string{"*"}, webhookEvents...)),
+		},
+		Set: schema.HashString,
+	}
+}
+
+// resourceGithubWebhookCustomizeDiff rejects the wildcard event `*` when it
+// is combined with other events, as it already subscribes to all of them. It
+// also rejects verifying a hook with a test push event when the hook isn't
+// subscribed to push events, as GitHub then doesn't deliver anything.
+func resourceGithubWebhookCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	events := d.Get("events").(*schema.Set)
+	if events.Contains("*") && events.Len() > 1 {
+		return fmt.Errorf("events: \"*\" subscribes to all events and can't be combined with other events")
+	}
+
+	if d.Get("verify").(string) == "test" && d.NewValueKnown("events") &&
+		!events.Contains("*") && !events.Contains("push") {
+		return fmt.Errorf("verify: \"test\" triggers a push event, but the webhook isn't subscribed to push events")
+	}
+	return nil
+}
+
+// webhookConfigurationSchema is the `configuration` block shared by the
+// repository and organization webhook resources.
+func webhookConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"content_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "form",
+					ValidateFunc: validateValueFunc([]string{"form", "json"}),
+				},
+				"insecure_ssl": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"secret": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+// expandWebhookConfiguration converts the `configuration` block into the
+// config map of a github.Hook.
+func expandWebhookConfiguration(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	v := d.Get("configuration").([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return config
+	}
+	c := v[0].(map[string]interface{})
+
+	config["url"] = c["url"].(string)
+	config["content_type"] = c["content_type"].(string)
+	config["insecure_ssl"] = "0"
+	if c["insecure_ssl"].(bool) {
+		config["insecure_ssl"] = "1"
+	}
+	if secret := c["secret"].(string); secret != "" {
+		config["secret"] = secret
+	}
+
+	return config
+}
+
+// flattenWebhookConfiguration converts the config map of a github.Hook into
+// the `configuration` block. GitHub masks the secret, so the secret already
+// in the state is kept instead of the masked value.
+func flattenWebhookConfiguration(d *schema.ResourceData, config map[string]interface{}) []interface{} {
+	if len(config) == 0 {
+		return []interface{}{}
+	}
+
+	c := map[string]interface{}{
+		"url":          config["url"],
+		"content_type": config["content_type"],
+		"insecure_ssl": isWebhookInsecureSSL(config["insecure_ssl"]),
+	}
+
+	if secret, ok := config["secret"].(string); ok {
+		if secret == maskedWebhookSecret {
+			secret = d.Get("configuration.0.secret").(string)
+		}
+		c["secret"] = secret
+	}
+
+	return []interface{}{c}
+}
+
+// isWebhookInsecureSSL interprets the insecure_ssl setting of a webhook,
+// which GitHub returns as "0" or "1" but older configurations may have set
+// as "true" or "false".
+func isWebhookInsecureSSL(v interface{}) bool {
+	switch s := fmt.Sprintf("%v", v); strings.ToLower(s) {
+	case "1", "true":
+		return true
+	}
+	return false
+}
+
+// webhookLastResponseSchema is the computed `last_response` block shared by
+// the repository and organization webhook resources.
+func webhookLastResponseSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// webhookVerifyTimeout is how long to wait for the receiver of a webhook to
+// respond to a verification delivery.
+const webhookVerifyTimeout = 1 * time.Minute
+
+var errWebhookNoNewResponse = errors.New("webhook has not received a new response yet")
+
+// webhook extends github.Hook with the last response of its receiver, which
+// the vendored go-github doesn't know about yet.
+type webhook struct {
+	*github.Hook
+
+	LastResponse *webhookLastResponse `json:"last_response,omitempty"`
+}
+
+type webhookLastResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// getGithubWebhook is the equivalent of Repositories.GetHook and
+// Organizations.GetHook, u being the URL of the hook relative to the API.
+func getGithubWebhook(client *github.Client, u string) (*webhook, *github.Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(webhook)
+	resp, err := client.Do(context.TODO(), req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hook, resp, nil
+}
+
+func flattenWebhookLastResponse(r *webhookLastResponse) []interface{} {
+	if r == nil {
+		return []interface{}{}
+	}
+
+	code := 0
+	if r.Code != nil {
+		code = *r.Code
+	}
+	status := ""
+	if r.Status != nil {
+		status = *r.Status
+	}
+	message := ""
+	if r.Message != nil {
+		message = *r.Message
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code":    code,
+			"status":  status,
+			"message": message,
+		},
+	}
+}
+
+// listGithubWebhooks is the equivalent of Repositories.ListHooks and
+// Organizations.ListHooks, returning the hooks of all pages at u with their
+// last response.
+func listGithubWebhooks(client *github.Client, u string) ([]*webhook, error) {
+	hooks := []*webhook{}
+
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		qs, err := query.Values(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?%s", u, qs.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []*webhook
+		resp, err := client.Do(context.TODO(), req, &page)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return hooks, nil
+}
+
+// dataSourceGithubWebhooksSchema is the `webhooks` list shared by the
+// repository and organization webhooks data sources. The secret of the hooks
+// is deliberately left out.
+func dataSourceGithubWebhooksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"url": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"content_type": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"insecure_ssl": &schema.Schema{
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"events": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"active": &schema.Schema{
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"last_response": webhookLastResponseSchema(),
+			},
+		},
+	}
+}
+
+func flattenGithubWebhooks(hooks []*webhook) []interface{} {
+	result := []interface{}{}
+	for _, h := range hooks {
+		url, _ := h.Config["url"].(string)
+		contentType, _ := h.Config["content_type"].(string)
+
+		result = append(result, map[string]interface{}{
+			"id":            fromGithubID(h.ID),
+			"name":          h.GetName(),
+			"url":           url,
+			"content_type":  contentType,
+			"insecure_ssl":  isWebhookInsecureSSL(h.Config["insecure_ssl"]),
+			"events":        h.Events,
+			"active":        h.GetActive(),
+			"last_response": flattenWebhookLastResponse(h.LastResponse),
+		})
+	}
+	return result
+}
+
+// verifyGithubWebhook makes GitHub deliver an event to the receiver of the
+// hook at u and waits for the delivery to show up in the recent deliveries of
+// the hook. When failOnError is set, it fails if the receiver didn't respond
+// with a 2xx status code or no delivery showed up within webhookVerifyTimeout.
+func verifyGithubWebhook(client *github.Client, u, event string, failOnError bool, deliver func() (*github.Response, error)) error {
+	before, err := listGithubWebhookDeliveries(client, u)
+	if err != nil {
+		return err
+	}
+	var lastID int64
+	for _, d := range before {
+		if d.GetID() > lastID {
+			lastID = d.GetID()
+		}
+	}
+
+	log.Printf("[DEBUG] Verifying webhook: %s", u)
+	if _, err := deliver(); err != nil {
+		return err
+	}
+
+	var delivery *webhookDelivery
+	err = resource.Retry(webhookVerifyTimeout, func() *resource.RetryError {
+		after, err := listGithubWebhookDeliveries(client, u)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		delivery = findNewWebhookDelivery(after, lastID, event)
+		if delivery == nil {
+			return resource.RetryableError(errWebhookNoNewResponse)
+		}
+		return nil
+	})
+	if err == errWebhookNoNewResponse {
+		if failOnError {
+			return fmt.Errorf("webhook receiver didn't get the %s event within %s", event, webhookVerifyTimeout)
+		}
+		log.Printf("[WARN] No %s event was delivered to the receiver of webhook %s", event, u)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if failOnError {
+		return checkWebhookDelivery(delivery)
+	}
+	return nil
+}
+
+// webhookDelivery is a delivery of a webhook to its receiver, which the
+// vendored go-github doesn't know about yet.
+type webhookDelivery struct {
+	ID         *int64  `json:"id,omitempty"`
+	Event      *string `json:"event,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	StatusCode *int    `json:"status_code,omitempty"`
+}
+
+func (d *webhookDelivery) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
+	}
+	return *d.ID
+}
+
+// listGithubWebhookDeliveries returns the most recent deliveries of the hook
+// at u, u being the URL of the hook relative to the API.
+func listGithubWebhookDeliveries(client *github.Client, u string) ([]*webhookDelivery, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("%s/deliveries?per_page=%d", u, maxPerPage), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*webhookDelivery
+	if _, err := client.Do(context.TODO(), req, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// findNewWebhookDelivery returns the newest delivery of the given event with
+// an ID greater than lastID, or nil if there is none. Delivery IDs increase
+// with every delivery, including redeliveries of the same payload.
+func findNewWebhookDelivery(deliveries []*webhookDelivery, lastID int64, event string) *webhookDelivery {
+	var found *webhookDelivery
+	for _, d := range deliveries {
+		if d.GetID() <= lastID || d.Event == nil || *d.Event != event {
+			continue
+		}
+		if found == nil || d.GetID() > found.GetID() {
+			found = d
+		}
+	}
+	return found
+}
+
+// checkWebhookDelivery fails when the receiver of the hook didn't respond to
+// the delivery with a 2xx status code.
+func checkWebhookDelivery(d *webhookDelivery) error {
+	code := 0
+	if d.StatusCode != nil {
+		code = *d.StatusCode
+	}
+	if code < 200 || code > 299 {
+		status := ""
+		if d.Status != nil {
+			status = *d.Status
+		}
+		return fmt.Errorf("webhook receiver responded with %d (%s)", code, status)
+	}
+	return nil
+}
+
+// findGithubRepositoryWebhook returns the hook of a repository delivering to
+// the given URL, or nil if there is none.
+func findGithubRepositoryWebhook(client *github.Client, owner, repo, url string) (*github.Hook, error) {
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		hooks, resp, err := client.Repositories.ListHooks(context.TODO(), owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		if hook := findWebhookByURL(hooks, url); hook != nil {
+			return hook, nil
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// findGithubOrganizationWebhook returns the hook of an organization
+// delivering to the given URL, or nil if there is none.
+func findGithubOrganizationWebhook(client *github.Client, org, url string) (*github.Hook, error) {
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		hooks, resp, err := client.Organizations.ListHooks(context.TODO(), org, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		if hook := findWebhookByURL(hooks, url); hook != nil {
+			return hook, nil
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func findWebhookByURL(hooks []*github.Hook, url string) *github.Hook {
+	for _, h := range hooks {
+		if u, ok := h.Config["url"].(string); ok && u == url {
+			return h
+		}
+	}
+	return nil
+}
+
+// resourceGithubWebhookMigrateState migrates the state of the repository and
+// organization webhook resources.
+func resourceGithubWebhookMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		return migrateGithubWebhookStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateGithubWebhookStateV0toV1 moves the untyped `configuration` map of
+// schema version 0 into the `configuration` block.
+func migrateGithubWebhookStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		return is, nil
+	}
+
+	prefix := "configuration."
+	config := make(map[string]string)
+	for k, v := range is.Attributes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		delete(is.Attributes, k)
+
+		key := strings.TrimPrefix(k, prefix)
+		if key != "%" && key != "#" {
+			config[key] = v
+		}
+	}
+
+	if len(config) == 0 {
+		is.Attributes["configuration.#"] = "0"
+		return is, nil
+	}
+
+	contentType := config["content_type"]
+	if contentType == "" {
+		contentType = "form"
+	}
+
+	is.Attributes["configuration.#"] = "1"
+	is.Attributes["configuration.0.url"] = config["url"]
+	is.Attributes["configuration.0.content_type"] = contentType
+	is.Attributes["configuration.0.insecure_ssl"] = fmt.Sprintf("%t", isWebhookInsecureSSL(config["insecure_ssl"]))
+	is.Attributes["configuration.0.secret"] = config["secret"]
+
+	return is, nil
+}
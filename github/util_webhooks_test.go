@@ -0,0 +1,159 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubUtilMigrateWebhookStateV0toV1(t *testing.T) {
+	cases := map[string]struct {
+		Attributes map[string]string
+		Expected   map[string]string
+	}{
+		"full configuration": {
+			Attributes: map[string]string{
+				"name":                       "web",
+				"configuration.%":            "4",
+				"configuration.url":          "https://google.de/webhook",
+				"configuration.content_type": "json",
+				"configuration.insecure_ssl": "1",
+				"configuration.secret":       "secret",
+			},
+			Expected: map[string]string{
+				"name":                         "web",
+				"configuration.#":              "1",
+				"configuration.0.url":          "https://google.de/webhook",
+				"configuration.0.content_type": "json",
+				"configuration.0.insecure_ssl": "true",
+				"configuration.0.secret":       "secret",
+			},
+		},
+		"partial configuration": {
+			Attributes: map[string]string{
+				"configuration.%":   "1",
+				"configuration.url": "https://google.de/webhook",
+			},
+			Expected: map[string]string{
+				"configuration.#":              "1",
+				"configuration.0.url":          "https://google.de/webhook",
+				"configuration.0.content_type": "form",
+				"configuration.0.insecure_ssl": "false",
+				"configuration.0.secret":       "",
+			},
+		},
+		"no configuration": {
+			Attributes: map[string]string{
+				"name":            "web",
+				"configuration.%": "0",
+			},
+			Expected: map[string]string{
+				"name":            "web",
+				"configuration.#": "0",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "12345",
+			Attributes: tc.Attributes,
+		}
+
+		is, err := resourceGithubWebhookMigrateState(0, is, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("%s: expected attributes %v, actual: %v", name, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestAccGithubUtilFlattenWebhookConfiguration(t *testing.T) {
+	d := resourceGithubRepositoryWebhook().TestResourceData()
+	d.Set("configuration", []interface{}{
+		map[string]interface{}{
+			"url":          "https://google.de/webhook",
+			"content_type": "json",
+			"insecure_ssl": false,
+			"secret":       "secret",
+		},
+	})
+
+	actual := flattenWebhookConfiguration(d, map[string]interface{}{
+		"url":          "https://google.de/webhook",
+		"content_type": "json",
+		"insecure_ssl": "0",
+		"secret":       maskedWebhookSecret,
+	})
+	expected := []interface{}{
+		map[string]interface{}{
+			"url":          "https://google.de/webhook",
+			"content_type": "json",
+			"insecure_ssl": false,
+			"secret":       "secret",
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected configuration %v, actual: %v", expected, actual)
+	}
+}
+
+func TestAccGithubUtilFindNewWebhookDelivery(t *testing.T) {
+	delivery := func(id int64, event string) *webhookDelivery {
+		return &webhookDelivery{ID: github.Int64(id), Event: github.String(event)}
+	}
+	deliveries := []*webhookDelivery{
+		delivery(7, "push"),
+		delivery(6, "ping"),
+		delivery(5, "ping"),
+	}
+
+	cases := []struct {
+		LastID   int64
+		Event    string
+		Expected int64
+	}{
+		{5, "ping", 6},
+		{4, "ping", 6},
+		{6, "ping", 0},
+		{6, "push", 7},
+		{7, "push", 0},
+		{0, "issues", 0},
+	}
+
+	for _, tc := range cases {
+		actual := findNewWebhookDelivery(deliveries, tc.LastID, tc.Event).GetID()
+		if actual != tc.Expected {
+			t.Fatalf("Expected delivery %d for %+v, actual: %d", tc.Expected, tc, actual)
+		}
+	}
+}
+
+func TestAccGithubUtilCheckWebhookDelivery(t *testing.T) {
+	cases := []struct {
+		Code        *int
+		ExpectError bool
+	}{
+		{github.Int(200), false},
+		{github.Int(204), false},
+		{github.Int(500), true},
+		{github.Int(0), true},
+		{nil, true},
+	}
+
+	for _, tc := range cases {
+		err := checkWebhookDelivery(&webhookDelivery{StatusCode: tc.Code, Status: github.String("OK")})
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %+v", tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for %+v, actual: %s", tc, err)
+		}
+	}
+}